@@ -7,11 +7,13 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v3/app/ante"
 	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	celestiaproof "github.com/celestiaorg/celestia-app/v3/app/grpc/proof"
 	celestiatx "github.com/celestiaorg/celestia-app/v3/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v3/app/module"
 	"github.com/celestiaorg/celestia-app/v3/app/posthandler"
 	appv1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
 	appv2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	appv3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
 	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
 	blobkeeper "github.com/celestiaorg/celestia-app/v3/x/blob/keeper"
 	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
@@ -108,6 +110,7 @@ var maccPerms = map[string][]string{
 const (
 	v1                    = appv1.Version
 	v2                    = appv2.Version
+	v3                    = appv3.Version
 	DefaultInitialVersion = v1
 )
 
@@ -149,6 +152,8 @@ type App struct {
 	UpgradeKeeper       upgradekeeper.Keeper // This is included purely for the IBC Keeper. It is not used for upgrading
 	SignalKeeper        signal.Keeper
 	ParamsKeeper        paramskeeper.Keeper
+	ParamFilterKeeper   paramfilter.Keeper
+	TokenFilterKeeper   tokenfilter.Keeper
 	IBCKeeper           *ibckeeper.Keeper // IBCKeeper must be a pointer in the app, so we can SetRouter on it correctly
 	EvidenceKeeper      evidencekeeper.Keeper
 	TransferKeeper      ibctransferkeeper.Keeper
@@ -277,7 +282,12 @@ func New(
 		),
 	)
 
-	app.SignalKeeper = signal.NewKeeper(appCodec, keys[signaltypes.StoreKey], app.StakingKeeper)
+	app.SignalKeeper = signal.NewKeeper(
+		appCodec,
+		keys[signaltypes.StoreKey],
+		app.GetSubspace(signaltypes.ModuleName),
+		app.StakingKeeper,
+	)
 
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec,
@@ -300,16 +310,20 @@ func New(
 		app.MsgServiceRouter(),
 	)
 
-	paramBlockList := paramfilter.NewParamBlockList(app.BlockedParams()...)
+	app.ParamFilterKeeper = paramfilter.NewKeeper(
+		app.ParamsKeeper,
+		paramfilter.NewParamBlockList(app.BlockedParams()...),
+		app.ParamBounds()...,
+	)
 
 	// Register the proposal types.
 	govRouter := oldgovtypes.NewRouter()
-	govRouter.AddRoute(paramproposal.RouterKey, paramBlockList.GovHandler(app.ParamsKeeper)).
+	govRouter.AddRoute(paramproposal.RouterKey, app.ParamFilterKeeper.GovHandler()).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
 
 	// Create Transfer Keepers.
-	tokenFilterKeeper := tokenfilter.NewKeeper(app.IBCKeeper.ChannelKeeper)
+	app.TokenFilterKeeper = tokenfilter.NewKeeper(app.IBCKeeper.ChannelKeeper, app.GetSubspace(tokenfilter.ModuleName))
 
 	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
 		appCodec,
@@ -319,7 +333,7 @@ func New(
 		app.IBCKeeper.ChannelKeeper,
 		app.DistrKeeper,
 		app.BankKeeper,
-		tokenFilterKeeper,
+		app.TokenFilterKeeper,
 	)
 
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
@@ -340,11 +354,11 @@ func New(
 		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp, // forward timeout
 		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,  // refund timeout
 	)
-	// PacketForwardMiddleware is used only for version 2.
-	transferStack = module.NewVersionedIBCModule(packetForwardMiddleware, transferStack, v2, v2)
+	// PacketForwardMiddleware is used from version 2.
+	transferStack = module.NewVersionedIBCModule(packetForwardMiddleware, transferStack, v2, v3)
 	// Token filter wraps packet forward middleware and is thus the first module in the transfer stack.
-	tokenFilterMiddelware := tokenfilter.NewIBCMiddleware(transferStack)
-	transferStack = module.NewVersionedIBCModule(tokenFilterMiddelware, transferStack, v1, v2)
+	tokenFilterMiddelware := tokenfilter.NewIBCMiddleware(transferStack, app.TokenFilterKeeper)
+	transferStack = module.NewVersionedIBCModule(tokenFilterMiddelware, transferStack, v1, v3)
 
 	app.EvidenceKeeper = *evidencekeeper.NewKeeper(
 		appCodec,
@@ -391,8 +405,13 @@ func New(
 	// order begin block, end block and init genesis
 	app.setModuleOrder()
 
-	app.QueryRouter().AddRoute(proof.TxInclusionQueryPath, proof.QueryTxInclusionProof)
-	app.QueryRouter().AddRoute(proof.ShareInclusionQueryPath, proof.QueryShareInclusionProof)
+	// The custom ABCI proof query paths are deprecated in favour of the
+	// celestia.core.v1.proof.Query gRPC service but remain registered for
+	// existing clients.
+	app.QueryRouter().AddRoute(proof.TxInclusionQueryPath, proof.QueryTxInclusionProof)                 //nolint:staticcheck
+	app.QueryRouter().AddRoute(proof.ShareInclusionQueryPath, proof.QueryShareInclusionProof)           //nolint:staticcheck
+	app.QueryRouter().AddRoute(proof.MultiShareInclusionQueryPath, proof.QueryMultiShareInclusionProof) //nolint:staticcheck
+	app.QueryRouter().AddRoute(proof.NamespaceAbsenceQueryPath, proof.QueryNamespaceAbsenceProof)       //nolint:staticcheck
 
 	app.manager.RegisterInvariants(&app.CrisisKeeper)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
@@ -421,6 +440,7 @@ func New(
 		ante.DefaultSigVerificationGasConsumer,
 		app.IBCKeeper,
 		app.ParamsKeeper,
+		app.ParamFilterKeeper,
 		app.MsgGateKeeper,
 	))
 	app.SetPostHandler(posthandler.New())
@@ -728,6 +748,7 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	celestiatx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	celestiaproof.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 }
 
 // RegisterTxService implements the Application.RegisterTxService method.
@@ -743,10 +764,16 @@ func (app *App) RegisterTendermintService(clientCtx client.Context) {
 
 func (app *App) RegisterNodeService(clientCtx client.Context) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
+	// The data commitment queries read the data roots from the node so they
+	// are registered alongside the node service.
+	blobstreamkeeper.RegisterDataCommitmentQueryService(app.GRPCQueryRouter(), app.BlobstreamKeeper, clientCtx)
+	// The proof queries read the blocks from the node too.
+	celestiaproof.RegisterProofService(app.GRPCQueryRouter(), clientCtx, app.govMaxSquareSizeAt)
 }
 
 // BlockedParams returns the params that require a hardfork to change, and
-// cannot be changed via governance.
+// cannot be changed via governance. Additional params can be blocked via
+// governance by extending the paramfilter.BlockedParams param.
 func (app *App) BlockedParams() [][2]string {
 	return [][2]string{
 		// bank.SendEnabled
@@ -760,6 +787,19 @@ func (app *App) BlockedParams() [][2]string {
 	}
 }
 
+// ParamBounds returns the restrictions on the values that governance can set
+// for params that are not blocked.
+func (app *App) ParamBounds() []paramfilter.ParamBound {
+	return []paramfilter.ParamBound{
+		// blob.GovMaxSquareSize may at most double or halve per proposal
+		paramfilter.NewParamBound(blobtypes.ModuleName, string(blobtypes.KeyGovMaxSquareSize)).
+			WithMaxChangeFactor(sdk.NewDec(2)),
+		// minfee.NetworkMinGasPrice must stay within [0.000001, 1] utia
+		paramfilter.NewParamBound(minfee.ModuleName, string(minfee.KeyNetworkMinGasPrice)).
+			WithRange(minfee.DefaultNetworkMinGasPrice, sdk.OneDec()),
+	}
+}
+
 // initParamsKeeper initializes the params keeper and its subspaces.
 func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey storetypes.StoreKey) paramskeeper.Keeper {
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey)
@@ -778,6 +818,9 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(blobtypes.ModuleName)
 	paramsKeeper.Subspace(blobstreamtypes.ModuleName)
 	paramsKeeper.Subspace(minfee.ModuleName)
+	paramsKeeper.Subspace(signaltypes.ModuleName)
+	paramsKeeper.Subspace(paramfilter.ModuleName)
+	paramsKeeper.Subspace(tokenfilter.ModuleName)
 	paramsKeeper.Subspace(packetforwardtypes.ModuleName)
 
 	return paramsKeeper
@@ -0,0 +1,303 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/cosmos/cosmos-sdk/client"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NodeClient is the subset of the consensus node RPC used to read the blocks.
+type NodeClient interface {
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+}
+
+// GovMaxSquareSizeFn returns the governance max square size in the state at
+// the given height. It must not query the application through the node: the
+// proof queries can be routed through ABCI, which holds the lock of the ABCI
+// connections for the duration of the query.
+type GovMaxSquareSizeFn func(ctx context.Context, height int64) (uint64, error)
+
+// RegisterProofService registers the proof query service on the gRPC router.
+// The blocks are read from the node of clientCtx and the governance max square
+// sizes are read from the application state using govMaxSquareSize.
+func RegisterProofService(qrt gogogrpc.Server, clientCtx client.Context, govMaxSquareSize GovMaxSquareSizeFn) {
+	proof.RegisterQueryServer(qrt, NewProofServer(
+		func() (NodeClient, error) {
+			return clientCtx.GetNode()
+		},
+		govMaxSquareSize,
+	))
+}
+
+// RegisterGRPCGatewayRoutes mounts the proof service's GRPC-gateway routes on
+// the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := proof.RegisterQueryHandlerClient(context.Background(), mux, proof.NewQueryClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ proof.QueryServer = &proofServer{}
+
+type proofServer struct {
+	node             func() (NodeClient, error)
+	govMaxSquareSize GovMaxSquareSizeFn
+}
+
+// NewProofServer returns a proof QueryServer that reads the blocks from node
+// and resolves their max square size using govMaxSquareSize.
+func NewProofServer(node func() (NodeClient, error), govMaxSquareSize GovMaxSquareSizeFn) proof.QueryServer {
+	return &proofServer{node: node, govMaxSquareSize: govMaxSquareSize}
+}
+
+// BlobProof implements the Query/BlobProof gRPC method.
+func (s *proofServer) BlobProof(ctx context.Context, req *proof.QueryBlobProofRequest) (*proof.QueryBlobProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	txHash, err := hex.DecodeString(req.TxHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash: %s", err)
+	}
+
+	block, maxSquareSize, err := s.block(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	txIndex := -1
+	for i, tx := range block.Data.Txs {
+		if bytes.Equal(tx.Hash(), txHash) {
+			txIndex = i
+			break
+		}
+	}
+	if txIndex == -1 {
+		return nil, status.Errorf(codes.NotFound, "tx %s not found at height %d", req.TxHash, req.Height)
+	}
+
+	txs := block.Data.Txs.ToSliceOfBytes()
+	subtreeRootThreshold := appconsts.SubtreeRootThreshold(block.Header.Version.App)
+	blobRange, err := square.BlobShareRange(txs, txIndex, int(req.BlobIndex), maxSquareSize, subtreeRootThreshold)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "blob %d of tx %s: %s", req.BlobIndex, req.TxHash, err)
+	}
+
+	shareProof, err := shareInclusionProof(block, maxSquareSize, blobRange)
+	if err != nil {
+		return nil, err
+	}
+	return &proof.QueryBlobProofResponse{
+		Start: uint32(blobRange.Start),
+		End:   uint32(blobRange.End),
+		Proof: shareProof,
+	}, nil
+}
+
+// TxInclusionProof implements the Query/TxInclusionProof gRPC method.
+func (s *proofServer) TxInclusionProof(ctx context.Context, req *proof.QueryTxInclusionProofRequest) (*proof.QueryTxInclusionProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	block, maxSquareSize, err := s.block(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	if int(req.TxIndex) >= len(block.Data.Txs) {
+		return nil, status.Errorf(codes.InvalidArgument, "tx index %d out of bounds: block %d has %d txs", req.TxIndex, req.Height, len(block.Data.Txs))
+	}
+
+	txs := block.Data.Txs.ToSliceOfBytes()
+	txRange, err := square.TxShareRange(txs, int(req.TxIndex), maxSquareSize, appconsts.SubtreeRootThreshold(block.Header.Version.App))
+	if err != nil {
+		return nil, err
+	}
+	shareProof, err := shareInclusionProof(block, maxSquareSize, txRange)
+	if err != nil {
+		return nil, err
+	}
+	return &proof.QueryTxInclusionProofResponse{
+		Start: uint32(txRange.Start),
+		End:   uint32(txRange.End),
+		Proof: shareProof,
+	}, nil
+}
+
+// ShareInclusionProof implements the Query/ShareInclusionProof gRPC method.
+func (s *proofServer) ShareInclusionProof(ctx context.Context, req *proof.QueryShareInclusionProofRequest) (*proof.QueryShareInclusionProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	block, maxSquareSize, err := s.block(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	shareProof, err := shareInclusionProof(block, maxSquareSize, share.NewRange(int(req.Start), int(req.End)))
+	if err != nil {
+		return nil, err
+	}
+	return &proof.QueryShareInclusionProofResponse{Proof: shareProof}, nil
+}
+
+// RowInclusionProof implements the Query/RowInclusionProof gRPC method.
+func (s *proofServer) RowInclusionProof(ctx context.Context, req *proof.QueryRowInclusionProofRequest) (*proof.QueryRowInclusionProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	block, maxSquareSize, err := s.block(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	_, eds, err := constructSquare(block, maxSquareSize)
+	if err != nil {
+		return nil, err
+	}
+	rowProof, err := proof.NewRowProof(eds, req.StartRow, req.EndRow)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &proof.QueryRowInclusionProofResponse{Proof: &rowProof}, nil
+}
+
+// MultiShareProof implements the Query/MultiShareProof gRPC method.
+func (s *proofServer) MultiShareProof(ctx context.Context, req *proof.QueryMultiShareProofRequest) (*proof.QueryMultiShareProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	shareRanges := make([]share.Range, len(req.Ranges))
+	for i, shareRange := range req.Ranges {
+		if shareRange == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "share range %d cannot be nil", i)
+		}
+		shareRanges[i] = share.NewRange(int(shareRange.Start), int(shareRange.End))
+	}
+
+	block, maxSquareSize, err := s.block(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	_, eds, err := constructSquare(block, maxSquareSize)
+	if err != nil {
+		return nil, err
+	}
+	// the share ranges are validated when building the proof
+	multiShareProof, err := proof.NewMultiShareInclusionProofFromEDS(eds, shareRanges)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &proof.QueryMultiShareProofResponse{Proof: &multiShareProof}, nil
+}
+
+// NamespaceAbsenceProof implements the Query/NamespaceAbsenceProof gRPC method.
+func (s *proofServer) NamespaceAbsenceProof(ctx context.Context, req *proof.QueryNamespaceAbsenceProofRequest) (*proof.QueryNamespaceAbsenceProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	rawNamespace, err := hex.DecodeString(req.Namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace: %s", err)
+	}
+	namespace, err := share.NewNamespaceFromBytes(rawNamespace)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace: %s", err)
+	}
+
+	block, maxSquareSize, err := s.block(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	_, eds, err := constructSquare(block, maxSquareSize)
+	if err != nil {
+		return nil, err
+	}
+	absenceProof, err := proof.NewNamespaceAbsenceProof(eds, namespace)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &proof.QueryNamespaceAbsenceProofResponse{Proof: &absenceProof}, nil
+}
+
+// shareInclusionProof constructs the data square of the block and returns the
+// proof of inclusion of the share range to its data root.
+func shareInclusionProof(block *tmtypes.Block, maxSquareSize int, shareRange share.Range) (*proof.ShareProof, error) {
+	dataSquare, eds, err := constructSquare(block, maxSquareSize)
+	if err != nil {
+		return nil, err
+	}
+	namespace, err := proof.ParseNamespace(dataSquare, shareRange.Start, shareRange.End)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	shareProof, err := proof.NewShareInclusionProofFromEDS(eds, namespace, shareRange)
+	if err != nil {
+		return nil, err
+	}
+	return &shareProof, nil
+}
+
+// block reads the block at height from the node and returns it along with the
+// max square size that was used to build its data square.
+func (s *proofServer) block(ctx context.Context, height int64) (*tmtypes.Block, int, error) {
+	if height <= 0 {
+		return nil, 0, status.Error(codes.InvalidArgument, "height must be positive")
+	}
+	node, err := s.node()
+	if err != nil {
+		return nil, 0, err
+	}
+	res, err := node.Block(ctx, &height)
+	if err != nil {
+		return nil, 0, err
+	}
+	if res.Block == nil {
+		return nil, 0, status.Errorf(codes.NotFound, "block %d not found", height)
+	}
+
+	// the data square of a block is built using the governance max square
+	// size of the state of the previous block. The first block is built using
+	// the default one, see App.MaxEffectiveSquareSize.
+	govMaxSquareSize := uint64(appconsts.DefaultGovMaxSquareSize)
+	if height > 1 {
+		govMaxSquareSize, err = s.govMaxSquareSize(ctx, height-1)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	maxSquareSize := min(int(govMaxSquareSize), appconsts.SquareSizeUpperBound(res.Block.Header.Version.App))
+	return res.Block, maxSquareSize, nil
+}
+
+// constructSquare constructs the data square of the block and checks that it
+// matches the data root of the block.
+func constructSquare(block *tmtypes.Block, maxSquareSize int) (square.Square, *rsmt2d.ExtendedDataSquare, error) {
+	dataSquare, err := square.Construct(block.Data.Txs.ToSliceOfBytes(), maxSquareSize, appconsts.SubtreeRootThreshold(block.Header.Version.App))
+	if err != nil {
+		return nil, nil, err
+	}
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	if err != nil {
+		return nil, nil, err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(dah.Hash(), block.Header.DataHash) {
+		return nil, nil, status.Errorf(codes.Internal, "constructed data root %X differs from the data root %X of block %d", dah.Hash(), block.Header.DataHash, block.Height)
+	}
+	return dataSquare, eds, nil
+}
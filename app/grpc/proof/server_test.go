@@ -0,0 +1,322 @@
+package proof_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	proofgrpc "github.com/celestiaorg/celestia-app/v3/app/grpc/proof"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const govMaxSquareSize = 32
+
+// fakeNode serves a single block.
+type fakeNode struct {
+	block *tmtypes.Block
+}
+
+var _ proofgrpc.NodeClient = &fakeNode{}
+
+func (n *fakeNode) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	if *height != n.block.Height {
+		return nil, errors.New("block not found")
+	}
+	return &coretypes.ResultBlock{Block: n.block}, nil
+}
+
+func TestBlobProof(t *testing.T) {
+	block, blobTxs := newTestBlock(t, 10)
+	var requestedHeight int64
+	server := proofgrpc.NewProofServer(
+		func() (proofgrpc.NodeClient, error) { return &fakeNode{block: block}, nil },
+		func(_ context.Context, height int64) (uint64, error) {
+			requestedHeight = height
+			return govMaxSquareSize, nil
+		},
+	)
+	ctx := context.Background()
+
+	for _, blobTx := range blobTxs {
+		txHash := hex.EncodeToString(tmtypes.Tx(blobTx).Hash())
+		resp, err := server.BlobProof(ctx, &proof.QueryBlobProofRequest{Height: block.Height, TxHash: txHash, BlobIndex: 0})
+		require.NoError(t, err)
+		// the square is built with the governance max square size of the
+		// previous block
+		assert.Equal(t, block.Height-1, requestedHeight)
+		require.NoError(t, resp.Proof.Validate(block.DataHash))
+		assert.Len(t, resp.Proof.Data, int(resp.End-resp.Start))
+
+		// the first share of the range is the start of the blob
+		firstShare, err := share.NewShare(resp.Proof.Data[0])
+		require.NoError(t, err)
+		assert.True(t, firstShare.IsSequenceStart())
+	}
+}
+
+func TestBlobProofErrors(t *testing.T) {
+	block, blobTxs := newTestBlock(t, 10)
+	server := newTestServer(block)
+	ctx := context.Background()
+	txHash := hex.EncodeToString(tmtypes.Tx(blobTxs[0]).Hash())
+
+	tests := []struct {
+		name string
+		req  *proof.QueryBlobProofRequest
+		code codes.Code
+	}{
+		{
+			name: "nil request",
+			req:  nil,
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "invalid tx hash",
+			req:  &proof.QueryBlobProofRequest{Height: block.Height, TxHash: "not hex"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "unknown tx",
+			req:  &proof.QueryBlobProofRequest{Height: block.Height, TxHash: hex.EncodeToString(tmrand.Bytes(32))},
+			code: codes.NotFound,
+		},
+		{
+			name: "blob index out of range",
+			req:  &proof.QueryBlobProofRequest{Height: block.Height, TxHash: txHash, BlobIndex: 1},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "invalid height",
+			req:  &proof.QueryBlobProofRequest{Height: 0, TxHash: txHash},
+			code: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := server.BlobProof(ctx, tt.req)
+			require.Error(t, err)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+
+	_, err := server.BlobProof(ctx, &proof.QueryBlobProofRequest{Height: block.Height + 1, TxHash: txHash})
+	assert.Error(t, err)
+}
+
+func TestBlobProofDataRootMismatch(t *testing.T) {
+	block, blobTxs := newTestBlock(t, 10)
+	block.DataHash = tmrand.Bytes(32)
+	server := newTestServer(block)
+
+	txHash := hex.EncodeToString(tmtypes.Tx(blobTxs[0]).Hash())
+	_, err := server.BlobProof(context.Background(), &proof.QueryBlobProofRequest{Height: block.Height, TxHash: txHash})
+	require.Error(t, err)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestTxInclusionProof(t *testing.T) {
+	block, _ := newTestBlock(t, 10)
+	server := newTestServer(block)
+	ctx := context.Background()
+
+	for i := range block.Data.Txs {
+		resp, err := server.TxInclusionProof(ctx, &proof.QueryTxInclusionProofRequest{Height: block.Height, TxIndex: uint32(i)})
+		require.NoError(t, err)
+		require.NoError(t, resp.Proof.Validate(block.DataHash))
+		assert.Len(t, resp.Proof.Data, int(resp.End-resp.Start))
+	}
+
+	_, err := server.TxInclusionProof(ctx, &proof.QueryTxInclusionProofRequest{Height: block.Height, TxIndex: uint32(len(block.Data.Txs))})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestShareInclusionProof(t *testing.T) {
+	block, _ := newTestBlock(t, 10)
+	server := newTestServer(block)
+	ctx := context.Background()
+
+	resp, err := server.ShareInclusionProof(ctx, &proof.QueryShareInclusionProofRequest{Height: block.Height, Start: 0, End: 2})
+	require.NoError(t, err)
+	require.NoError(t, resp.Proof.Validate(block.DataHash))
+	assert.Len(t, resp.Proof.Data, 2)
+
+	// the range spans the transactions and the blobs namespaces
+	squareSize := uint32(block.Data.SquareSize)
+	_, err = server.ShareInclusionProof(ctx, &proof.QueryShareInclusionProofRequest{Height: block.Height, Start: 0, End: squareSize * squareSize})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRowInclusionProof(t *testing.T) {
+	block, _ := newTestBlock(t, 10)
+	server := newTestServer(block)
+	ctx := context.Background()
+	squareSize := uint32(block.Data.SquareSize)
+
+	resp, err := server.RowInclusionProof(ctx, &proof.QueryRowInclusionProofRequest{Height: block.Height, StartRow: 0, EndRow: squareSize - 1})
+	require.NoError(t, err)
+	require.NoError(t, resp.Proof.Validate(block.DataHash))
+	assert.Len(t, resp.Proof.RowRoots, int(squareSize))
+
+	_, err = server.RowInclusionProof(ctx, &proof.QueryRowInclusionProofRequest{Height: block.Height, StartRow: 0, EndRow: squareSize})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestMultiShareProof(t *testing.T) {
+	block, blobTxs := newTestBlock(t, 10)
+	server := newTestServer(block)
+	ctx := context.Background()
+
+	// the blob transactions follow the other transactions of the block
+	txs := block.Data.Txs.ToSliceOfBytes()
+	req := &proof.QueryMultiShareProofRequest{Height: block.Height}
+	for i := range blobTxs {
+		blobRange, err := square.BlobShareRange(txs, len(txs)-len(blobTxs)+i, 0, govMaxSquareSize, appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+		require.NoError(t, err)
+		req.Ranges = append(req.Ranges, &proof.ShareRange{Start: uint32(blobRange.Start), End: uint32(blobRange.End)})
+	}
+	resp, err := server.MultiShareProof(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Proof.Validate(block.DataHash))
+	assert.Len(t, resp.Proof.Ranges, len(blobTxs))
+
+	// the range spans the transactions and the blobs namespaces
+	squareSize := uint32(block.Data.SquareSize)
+	_, err = server.MultiShareProof(ctx, &proof.QueryMultiShareProofRequest{Height: block.Height, Ranges: []*proof.ShareRange{{Start: 0, End: squareSize * squareSize}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.MultiShareProof(ctx, &proof.QueryMultiShareProofRequest{Height: block.Height, Ranges: []*proof.ShareRange{nil}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestNamespaceAbsenceProof(t *testing.T) {
+	block, _ := newTestBlock(t, 10)
+	server := newTestServer(block)
+	ctx := context.Background()
+
+	absent := share.MustNewV0Namespace(bytes.Repeat([]byte{3}, share.NamespaceVersionZeroIDSize))
+	resp, err := server.NamespaceAbsenceProof(ctx, &proof.QueryNamespaceAbsenceProofRequest{Height: block.Height, Namespace: hex.EncodeToString(absent.Bytes())})
+	require.NoError(t, err)
+	require.NoError(t, resp.Proof.Validate(newTestDAH(t, block)))
+
+	present := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	_, err = server.NamespaceAbsenceProof(ctx, &proof.QueryNamespaceAbsenceProofRequest{Height: block.Height, Namespace: hex.EncodeToString(present.Bytes())})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.NamespaceAbsenceProof(ctx, &proof.QueryNamespaceAbsenceProofRequest{Height: block.Height, Namespace: "not hex"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestProofServiceThroughABCI checks that the proof service can be queried
+// through ABCI as well as through gRPC. ABCI queries hold the lock of the ABCI
+// connections so the service must not query the application through the node.
+func TestProofServiceThroughABCI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping proof service through ABCI test in short mode.")
+	}
+	cctx, _, _ := testnode.NewNetwork(t, testnode.DefaultConfig())
+	height, err := cctx.WaitForHeight(3)
+	require.NoError(t, err)
+	blockRes, err := cctx.Client.Block(cctx.GoContext(), &height)
+	require.NoError(t, err)
+	req := &proof.QueryRowInclusionProofRequest{Height: height, StartRow: 0, EndRow: 0}
+
+	grpcResp, err := proof.NewQueryClient(cctx.GRPCClient).RowInclusionProof(cctx.GoContext(), req)
+	require.NoError(t, err)
+	require.NoError(t, grpcResp.Proof.Validate(blockRes.Block.DataHash))
+
+	bz, err := req.Marshal()
+	require.NoError(t, err)
+	type result struct {
+		res *coretypes.ResultABCIQuery
+		err error
+	}
+	// the local client doesn't stop the query when its context is done.
+	results := make(chan result, 1)
+	go func() {
+		res, err := cctx.Client.ABCIQueryWithOptions(cctx.GoContext(), "/celestia.core.v1.proof.Query/RowInclusionProof", bz, rpcclient.ABCIQueryOptions{})
+		results <- result{res: res, err: err}
+	}()
+	select {
+	case r := <-results:
+		require.NoError(t, r.err)
+		require.Equal(t, abci.CodeTypeOK, r.res.Response.Code, r.res.Response.Log)
+		var abciResp proof.QueryRowInclusionProofResponse
+		require.NoError(t, abciResp.Unmarshal(r.res.Response.Value))
+		require.NoError(t, abciResp.Proof.Validate(blockRes.Block.DataHash))
+	case <-time.After(10 * time.Second):
+		t.Fatal("the proof query through ABCI didn't return")
+	}
+
+	// the node keeps producing blocks
+	_, err = cctx.WaitForHeightWithTimeout(height+2, 10*time.Second)
+	require.NoError(t, err)
+}
+
+func newTestServer(block *tmtypes.Block) proof.QueryServer {
+	return proofgrpc.NewProofServer(
+		func() (proofgrpc.NodeClient, error) { return &fakeNode{block: block}, nil },
+		func(context.Context, int64) (uint64, error) { return govMaxSquareSize, nil },
+	)
+}
+
+// newTestDAH returns the data availability header of the block built by
+// newTestBlock.
+func newTestDAH(t *testing.T, block *tmtypes.Block) *da.DataAvailabilityHeader {
+	dataSquare, err := square.Construct(block.Data.Txs.ToSliceOfBytes(), govMaxSquareSize, appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	return &dah
+}
+
+// newTestBlock returns a block at height containing random transactions and
+// blob transactions, along with the blob transactions.
+func newTestBlock(t *testing.T, height int64) (*tmtypes.Block, [][]byte) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	namespaces := []share.Namespace{
+		share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize)),
+		share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize)),
+	}
+	blobTxs := tmtypes.Txs(blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, namespaces, []int{5000, 20000})).ToSliceOfBytes()
+	txs := append(testfactory.GenerateRandomTxs(20, 500).ToSliceOfBytes(), blobTxs...)
+
+	dataSquare, err := square.Construct(txs, govMaxSquareSize, appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	block := &tmtypes.Block{
+		Header: tmtypes.Header{
+			Version:  tmversion.Consensus{App: appconsts.LatestVersion},
+			Height:   height,
+			DataHash: dah.Hash(),
+		},
+		Data: tmtypes.Data{Txs: tmtypes.ToTxs(txs), SquareSize: uint64(dataSquare.Size())},
+	}
+	return block, blobTxs
+}
@@ -39,6 +39,7 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 		ante.DefaultSigVerificationGasConsumer,
 		app.IBCKeeper,
 		app.ParamsKeeper,
+		app.ParamFilterKeeper,
 		app.MsgGateKeeper,
 	)
 
@@ -47,6 +47,7 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 		ante.DefaultSigVerificationGasConsumer,
 		app.IBCKeeper,
 		app.ParamsKeeper,
+		app.ParamFilterKeeper,
 		app.MsgGateKeeper,
 	)
 	sdkCtx := app.NewProposalContext(req.Header)
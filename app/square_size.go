@@ -1,6 +1,8 @@
 package app
 
 import (
+	"context"
+
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -21,3 +23,14 @@ func (app *App) MaxEffectiveSquareSize(ctx sdk.Context) int {
 	hardMax := appconsts.SquareSizeUpperBound(app.AppVersion())
 	return min(govMax, hardMax)
 }
+
+// govMaxSquareSizeAt returns the governance max square size in the committed
+// state at height. It reads the state directly rather than querying it through
+// the node so that it can be used while serving ABCI queries.
+func (app *App) govMaxSquareSizeAt(_ context.Context, height int64) (uint64, error) {
+	ctx, err := app.CreateQueryContext(height, false)
+	if err != nil {
+		return 0, err
+	}
+	return app.BlobKeeper.GovMaxSquareSize(ctx), nil
+}
@@ -9,11 +9,13 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/app/encoding"
 	"github.com/celestiaorg/celestia-app/v3/app/grpc/tx"
 	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
 	"github.com/celestiaorg/celestia-app/v3/pkg/user"
 	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
 	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
 	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
 	signal "github.com/celestiaorg/celestia-app/v3/x/signal/types"
 	"github.com/celestiaorg/go-square/v2/share"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
@@ -269,11 +271,9 @@ func (s *StandardSDKIntegrationTestSuite) TestStandardSDK() {
 				require.NoError(t, err)
 				return []sdk.Msg{msg}, account
 			},
-			// this parameter is protected by the paramfilter module, and we
-			// should expect an error. Due to how errors are bubbled up, we get
-			// this code despite wrapping the expected error,
-			// paramfilter.ErrBlockedParameter
-			expectedCode: govtypes.ErrNoProposalHandlerExists.ABCICode(),
+			// this parameter is protected by the paramfilter module so the
+			// proposal is rejected when it is submitted
+			expectedCode: paramfilter.ErrBlockedParameter.ABCICode(),
 		},
 		{
 			name: "create param proposal change for a modifiable parameter",
@@ -307,7 +307,7 @@ func (s *StandardSDKIntegrationTestSuite) TestStandardSDK() {
 			name: "signal a version change",
 			msgFunc: func() (msgs []sdk.Msg, signer string) {
 				valAccount := s.getValidatorAccount()
-				msg := signal.NewMsgSignalVersion(valAccount, 2)
+				msg := signal.NewMsgSignalVersion(valAccount, v3.Version)
 				return []sdk.Msg{msg}, s.getValidatorName()
 			},
 			expectedCode: abci.CodeTypeOK,
@@ -325,6 +325,11 @@ func (s *StandardSDKIntegrationTestSuite) TestStandardSDK() {
 			if tt.expectedCode != abci.CodeTypeOK {
 				require.Error(t, err)
 				require.Nil(t, res)
+				// txs rejected by the ante handler are never included in a block
+				if broadcastErr, ok := err.(*user.BroadcastTxError); ok {
+					assert.Equal(t, tt.expectedCode, broadcastErr.Code, broadcastErr.ErrorLog)
+					return
+				}
 				txHash := err.(*user.ExecutionError).TxHash
 				code := err.(*user.ExecutionError).Code
 				getTxResp, err := serviceClient.GetTx(s.cctx.GoContext(), &sdktx.GetTxRequest{Hash: txHash})
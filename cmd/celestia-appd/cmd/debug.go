@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/spf13/cobra"
+)
+
+const (
+	proofTypeFlag = "type"
+
+	proofTypeShare = "share"
+	proofTypeRow   = "row"
+	proofTypeNMT   = "nmt"
+)
+
+// debugCommand returns the SDK debug command extended with the celestia
+// specific debug commands.
+func debugCommand() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(decodeProofCmd(), exportEDSCmd(), debugSquareCmd(), debugSharesCmd())
+	return cmd
+}
+
+// decodeProofCmd returns a command that decodes a share, row or NMT proof
+// and prints it in the canonical JSON format.
+func decodeProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-proof [file]",
+		Short: "Decode a share, row or NMT proof and pretty-print it",
+		Long: `Reads a proof from the file, or from stdin if the file is "-", and prints it in the canonical JSON format.
+The proof can be encoded in JSON, in the compact binary format or in protobuf, either raw or hex-encoded.
+The proof type is detected for the JSON and compact encodings and must be set using --type for protobuf.`,
+		Example: "celestia-appd debug decode-proof proof.bin\n" +
+			"celestia-appd debug decode-proof --type row proof.pb\n",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				data []byte
+				err  error
+			)
+			if args[0] == "-" {
+				data, err = io.ReadAll(cmd.InOrStdin())
+			} else {
+				data, err = os.ReadFile(args[0])
+			}
+			if err != nil {
+				return err
+			}
+			proofType, err := cmd.Flags().GetString(proofTypeFlag)
+			if err != nil {
+				return err
+			}
+
+			decoded, err := decodeProof(data, proofType)
+			if err != nil {
+				return err
+			}
+			bz, err := decoded.MarshalCanonicalJSON()
+			if err != nil {
+				return err
+			}
+			var out bytes.Buffer
+			if err := json.Indent(&out, bz, "", "  "); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), out.String())
+			return err
+		},
+	}
+	cmd.Flags().String(proofTypeFlag, "", fmt.Sprintf("proof type: %s, %s or %s. Required for protobuf encoded proofs", proofTypeShare, proofTypeRow, proofTypeNMT))
+	return cmd
+}
+
+// decodeProof detects the encoding of the proof and decodes it. proofType can
+// be empty unless the proof is encoded in protobuf.
+func decodeProof(data []byte, proofType string) (decodableProof, error) {
+	trimmed := bytes.TrimSpace(data)
+	isJSON := len(trimmed) > 0 && trimmed[0] == '{'
+	if !isJSON {
+		if bz, err := hex.DecodeString(string(trimmed)); err == nil {
+			data = bz
+		}
+	}
+
+	switch {
+	case isJSON:
+		if proofType == "" {
+			proofType = jsonProofType(data)
+		}
+		p, err := newProof(proofType)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.UnmarshalCanonicalJSON(data); err != nil {
+			return nil, fmt.Errorf("decoding JSON %s proof: %w", proofType, err)
+		}
+		return p, nil
+	case proofType == "" && len(data) > 0:
+		var p decodableProof
+		switch proof.CompactTag(data[0]) {
+		case proof.CompactTagShareProof:
+			p = &proof.ShareProof{}
+		case proof.CompactTagRowProof:
+			p = &proof.RowProof{}
+		case proof.CompactTagNMTProof:
+			p = &proof.NMTProof{}
+		default:
+			return nil, fmt.Errorf("unknown compact proof tag %#x, set --%s for protobuf encoded proofs", data[0], proofTypeFlag)
+		}
+		if err := p.UnmarshalCompact(data); err != nil {
+			return nil, fmt.Errorf("decoding compact proof: %w", err)
+		}
+		return p, nil
+	default:
+		p, err := newProof(proofType)
+		if err != nil {
+			return nil, err
+		}
+		// the compact encoding is tried first as its tags are not valid
+		// protobuf field keys.
+		if err := p.UnmarshalCompact(data); err == nil {
+			return p, nil
+		}
+		if err := p.Unmarshal(data); err != nil {
+			return nil, fmt.Errorf("decoding protobuf %s proof: %w", proofType, err)
+		}
+		return p, nil
+	}
+}
+
+// decodableProof is a proof that can be decoded from any of the supported
+// encodings.
+type decodableProof interface {
+	Unmarshal([]byte) error
+	UnmarshalCanonicalJSON([]byte) error
+	UnmarshalCompact([]byte) error
+	MarshalCanonicalJSON() ([]byte, error)
+}
+
+func newProof(proofType string) (decodableProof, error) {
+	switch proofType {
+	case proofTypeShare:
+		return &proof.ShareProof{}, nil
+	case proofTypeRow:
+		return &proof.RowProof{}, nil
+	case proofTypeNMT:
+		return &proof.NMTProof{}, nil
+	default:
+		return nil, fmt.Errorf("invalid proof type %q: must be %s, %s or %s", proofType, proofTypeShare, proofTypeRow, proofTypeNMT)
+	}
+}
+
+// jsonProofType infers the type of a JSON proof from its fields.
+func jsonProofType(data []byte) string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return ""
+	}
+	if _, ok := fields["row_proof"]; ok {
+		return proofTypeShare
+	}
+	if _, ok := fields["row_roots"]; ok {
+		return proofTypeRow
+	}
+	if _, ok := fields["nodes"]; ok {
+		return proofTypeNMT
+	}
+	return ""
+}
@@ -0,0 +1,255 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/shareparse"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+)
+
+const blobsDirFlag = "blobs-dir"
+
+// sharesReport is the report of the parsing of shares.
+type sharesReport struct {
+	// FirstShare is the index of the first parsed share, e.g. in the square
+	// of the block the shares were fetched from.
+	FirstShare    int `json:"first_share"`
+	Txs           int `json:"txs"`
+	PFBs          int `json:"pfbs"`
+	PaddingShares int `json:"padding_shares"`
+	// Namespaces are the blobs grouped by namespace, in the order of the
+	// shares.
+	Namespaces []namespaceBlobs `json:"namespaces"`
+	Errors     []shareError     `json:"errors"`
+}
+
+type namespaceBlobs struct {
+	Namespace string       `json:"namespace"`
+	Blobs     []parsedBlob `json:"blobs"`
+}
+
+type parsedBlob struct {
+	Start        int    `json:"start"`
+	End          int    `json:"end"`
+	ShareVersion uint8  `json:"share_version"`
+	Signer       string `json:"signer,omitempty"`
+	Size         int    `json:"size"`
+	// File is the file the blob data was written to, if any.
+	File string `json:"file,omitempty"`
+}
+
+type shareError struct {
+	Index  int    `json:"index"`
+	Offset int    `json:"offset"`
+	Error  string `json:"error"`
+}
+
+// debugSharesCmd returns the command group to parse shares.
+func debugSharesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shares",
+		Short: "Parse shares back to transactions and blobs",
+	}
+	cmd.AddCommand(parseSharesCmd(), shareRangeCmd())
+	return cmd
+}
+
+// parseSharesCmd returns a command that parses a file of shares.
+func parseSharesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "parse [file]",
+		Short: "Parse a file of shares",
+		Long: `Reads the shares from the file, or from stdin if the file is "-", and reassembles their transactions and blobs.
+The file is the concatenation of the shares, e.g. of the rows of an original data square.
+The sequence start and continuation info of every share is validated and the malformed shares are reported along with
+their index and byte offset in the file. The parsing continues after a malformed share.`,
+		Example: "celestia-appd debug shares parse shares.bin\n" +
+			"celestia-appd debug shares parse shares.bin --blobs-dir blobs --output json\n",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			r := cmd.InOrStdin()
+			if args[0] != "-" {
+				file, err := os.Open(args[0])
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				r = file
+			}
+			result, err := shareparse.ParseReader(r)
+			if err != nil {
+				return err
+			}
+			return reportShares(cmd, result, 0)
+		},
+	}
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+	cmd.Flags().String(blobsDirFlag, "", "Directory to write the data of the reassembled blobs to")
+	return cmd
+}
+
+// shareRangeCmd returns a command that parses a range of shares of the data
+// square of a block.
+func shareRangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "range [height] [start] [end]",
+		Short: "Parse a range of shares of the data square of a block",
+		Long: `Reads the block at the given height from the node, reconstructs its data square and parses the shares [start, end)
+of the square, in row-major order, like the parse command. The reported indexes and offsets are the ones in the square.`,
+		Example: "celestia-appd debug shares range 100 0 64 --node tcp://localhost:26657\n",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+			start, err := strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid start %s: %w", args[1], err)
+			}
+			end, err := strconv.Atoi(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid end %s: %w", args[2], err)
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			res, err := node.Block(cmd.Context(), &height)
+			if err != nil {
+				return err
+			}
+
+			eds, err := blockEDS(res.Block)
+			if err != nil {
+				return err
+			}
+			shares := eds.FlattenedODS()
+			if start < 0 || end > len(shares) || start >= end {
+				return fmt.Errorf("invalid share range [%d, %d) for a square of %d shares", start, end, len(shares))
+			}
+			return reportShares(cmd, shareparse.Parse(shares[start:end]), start)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(blobsDirFlag, "", "Directory to write the data of the reassembled blobs to")
+	return cmd
+}
+
+// reportShares writes the data of the blobs to the blobs directory, if any,
+// and prints the report of the result. firstShare is the index of the first
+// parsed share.
+func reportShares(cmd *cobra.Command, result shareparse.Result, firstShare int) error {
+	blobsDir, err := cmd.Flags().GetString(blobsDirFlag)
+	if err != nil {
+		return err
+	}
+	output, err := cmd.Flags().GetString(tmcli.OutputFlag)
+	if err != nil {
+		return err
+	}
+	report, err := newSharesReport(result, firstShare, blobsDir)
+	if err != nil {
+		return err
+	}
+	if output == "json" {
+		bz, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+		return err
+	}
+	return printSharesReport(cmd.OutOrStdout(), report)
+}
+
+// newSharesReport returns the report of the result. The data of the blobs is
+// written to blobsDir if it isn't empty.
+func newSharesReport(result shareparse.Result, firstShare int, blobsDir string) (sharesReport, error) {
+	if blobsDir != "" {
+		if err := os.MkdirAll(blobsDir, 0o755); err != nil {
+			return sharesReport{}, err
+		}
+	}
+	report := sharesReport{
+		FirstShare:    firstShare,
+		Txs:           len(result.Txs),
+		PFBs:          len(result.PFBs),
+		PaddingShares: result.PaddingShares,
+		Errors:        []shareError{},
+	}
+	for _, blob := range result.Blobs {
+		namespace := hex.EncodeToString(blob.Blob.Namespace().Bytes())
+		if n := len(report.Namespaces); n == 0 || report.Namespaces[n-1].Namespace != namespace {
+			report.Namespaces = append(report.Namespaces, namespaceBlobs{Namespace: namespace})
+		}
+		parsed := parsedBlob{
+			Start:        firstShare + blob.Start,
+			End:          firstShare + blob.End,
+			ShareVersion: blob.Blob.ShareVersion(),
+			Signer:       hex.EncodeToString(blob.Blob.Signer()),
+			Size:         blob.Blob.DataLen(),
+		}
+		if blobsDir != "" {
+			parsed.File = filepath.Join(blobsDir, fmt.Sprintf("%s_%d.bin", namespace, parsed.Start))
+			if err := os.WriteFile(parsed.File, blob.Blob.Data(), 0o600); err != nil {
+				return sharesReport{}, err
+			}
+		}
+		current := &report.Namespaces[len(report.Namespaces)-1]
+		current.Blobs = append(current.Blobs, parsed)
+	}
+	for _, e := range result.Errors {
+		report.Errors = append(report.Errors, shareError{
+			Index:  firstShare + e.Index,
+			Offset: firstShare*share.ShareSize + e.Offset,
+			Error:  e.Err.Error(),
+		})
+	}
+	return report, nil
+}
+
+// printSharesReport prints the report as tables.
+func printSharesReport(w io.Writer, report sharesReport) error {
+	fmt.Fprintf(w, "transactions: %d\npay for blob transactions: %d\npadding shares: %d\n", report.Txs, report.PFBs, report.PaddingShares)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if len(report.Namespaces) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(tw, "NAMESPACE\tSTART\tEND\tVERSION\tSIZE\tSIGNER\tFILE")
+		for _, ns := range report.Namespaces {
+			for _, blob := range ns.Blobs {
+				fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%s\t%s\n", ns.Namespace, blob.Start, blob.End, blob.ShareVersion, blob.Size, blob.Signer, blob.File)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	if len(report.Errors) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(w, "\n%d malformed shares:\n", len(report.Errors))
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "INDEX\tOFFSET\tERROR")
+	for _, e := range report.Errors {
+		fmt.Fprintf(tw, "%d\t%d\t%s\n", e.Index, e.Offset, e.Error)
+	}
+	return tw.Flush()
+}
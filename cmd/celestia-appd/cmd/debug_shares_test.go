@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/shareparse"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSharesCmd(t *testing.T) {
+	block, blobTxs := newDebugTestBlock(t)
+	eds, err := blockEDS(block)
+	require.NoError(t, err)
+	shares := eds.FlattenedODS()
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "shares.bin")
+	require.NoError(t, os.WriteFile(file, bytes.Join(shares, nil), 0o600))
+	blobsDir := filepath.Join(dir, "blobs")
+
+	output, err := executeCmd(parseSharesCmd(), file, "--output", "json", "--blobs-dir", blobsDir)
+	require.NoError(t, err)
+	var report sharesReport
+	require.NoError(t, json.Unmarshal([]byte(output), &report))
+	assert.Empty(t, report.Errors)
+	assert.Equal(t, len(block.Data.Txs)-len(blobTxs), report.Txs)
+	assert.Equal(t, len(blobTxs), report.PFBs)
+	require.Len(t, report.Namespaces, 2)
+	for _, ns := range report.Namespaces {
+		require.Len(t, ns.Blobs, 1)
+		data, err := os.ReadFile(ns.Blobs[0].File)
+		require.NoError(t, err)
+		assert.Len(t, data, ns.Blobs[0].Size)
+	}
+
+	// drop the first share of the first blob
+	start := report.Namespaces[0].Blobs[0].Start
+	malformed := append(append([][]byte{}, shares[:start]...), shares[start+1:]...)
+	require.NoError(t, os.WriteFile(file, bytes.Join(malformed, nil), 0o600))
+	output, err = executeCmd(parseSharesCmd(), file)
+	require.NoError(t, err)
+	assert.Contains(t, output, "malformed shares")
+	assert.Contains(t, output, "without a sequence start")
+}
+
+func TestNewSharesReportOffsets(t *testing.T) {
+	block, _ := newDebugTestBlock(t)
+	eds, err := blockEDS(block)
+	require.NoError(t, err)
+	shares := eds.FlattenedODS()
+	full, err := newSharesReport(shareparse.Parse(shares), 0, "")
+	require.NoError(t, err)
+	require.Len(t, full.Namespaces, 2)
+
+	// a range starting in the middle of the first blob
+	start := full.Namespaces[0].Blobs[0].Start + 1
+	report, err := newSharesReport(shareparse.Parse(shares[start:]), start, "")
+	require.NoError(t, err)
+	require.NotEmpty(t, report.Errors)
+	assert.Equal(t, start, report.Errors[0].Index)
+	assert.Equal(t, start*share.ShareSize, report.Errors[0].Offset)
+	require.Len(t, report.Namespaces, 1)
+	assert.Equal(t, full.Namespaces[1], report.Namespaces[0])
+}
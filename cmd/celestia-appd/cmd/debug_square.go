@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// squareLayout describes how the data square of a block is laid out.
+type squareLayout struct {
+	Height     int64 `json:"height"`
+	SquareSize int   `json:"square_size"`
+	// Namespaces are the ranges of consecutive shares of the same namespace,
+	// in the order of the square.
+	Namespaces []namespaceRange `json:"namespaces"`
+	Blobs      []blobLayout     `json:"blobs"`
+	// UsedShares is the number of shares of the square that are not padding.
+	UsedShares  int     `json:"used_shares"`
+	TotalShares int     `json:"total_shares"`
+	Utilization float64 `json:"utilization"`
+}
+
+type namespaceRange struct {
+	Namespace string `json:"namespace"`
+	Kind      string `json:"kind"`
+	Start     int    `json:"start"`
+	End       int    `json:"end"`
+	// PaddingShares is the number of padding shares in the range, e.g. the
+	// namespace padding shares following a blob.
+	PaddingShares int `json:"padding_shares"`
+}
+
+type blobLayout struct {
+	Namespace string `json:"namespace"`
+	TxHash    string `json:"tx_hash"`
+	BlobIndex int    `json:"blob_index"`
+	Start     int    `json:"start"`
+	Shares    int    `json:"shares"`
+	// Commitment is base64 encoded like in the MsgPayForBlobs.
+	Commitment string `json:"commitment"`
+}
+
+// debugSquareCmd returns a command that prints the layout of the data square
+// of a block.
+func debugSquareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "square [height]",
+		Short: "Print the layout of the data square of a block",
+		Long: `Reads the block at the given height from the node, reconstructs its data square and prints its layout:
+the ranges of shares of each namespace, e.g. the transactions, the PFBs, the blobs and the padding, the start index,
+number of shares and share commitment of each blob, and the utilization of the square.`,
+		Example: "celestia-appd debug square 100 --node tcp://localhost:26657\n" +
+			"celestia-appd debug square 100 --output json\n",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			res, err := node.Block(cmd.Context(), &height)
+			if err != nil {
+				return err
+			}
+
+			layout, err := newSquareLayout(res.Block)
+			if err != nil {
+				return err
+			}
+			if clientCtx.OutputFormat == "json" {
+				bz, err := json.MarshalIndent(layout, "", "  ")
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+				return err
+			}
+			return printSquareLayout(cmd.OutOrStdout(), layout)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// newSquareLayout reconstructs the data square of the block and returns its
+// layout.
+func newSquareLayout(block *tmtypes.Block) (squareLayout, error) {
+	builder, dataSquare, _, err := blockSquare(block)
+	if err != nil {
+		return squareLayout{}, err
+	}
+	subtreeRootThreshold := appconsts.SubtreeRootThreshold(block.Header.Version.App)
+	txs := block.Data.Txs.ToSliceOfBytes()
+
+	layout := squareLayout{
+		Height:      block.Height,
+		SquareSize:  dataSquare.Size(),
+		TotalShares: len(dataSquare),
+	}
+	for i, sh := range dataSquare {
+		namespace := sh.Namespace()
+		if n := len(layout.Namespaces); n == 0 || layout.Namespaces[n-1].Namespace != hex.EncodeToString(namespace.Bytes()) {
+			layout.Namespaces = append(layout.Namespaces, namespaceRange{
+				Namespace: hex.EncodeToString(namespace.Bytes()),
+				Kind:      namespaceKind(namespace),
+				Start:     i,
+			})
+		}
+		current := &layout.Namespaces[len(layout.Namespaces)-1]
+		current.End = i + 1
+		if sh.IsPadding() {
+			current.PaddingShares++
+		} else {
+			layout.UsedShares++
+		}
+	}
+	layout.Utilization = float64(layout.UsedShares) / float64(layout.TotalShares)
+
+	// the blobs are sorted by namespace once the square is exported.
+	for _, element := range builder.Blobs {
+		commitment, err := inclusion.CreateCommitment(element.Blob, merkle.HashFromByteSlices, subtreeRootThreshold)
+		if err != nil {
+			return squareLayout{}, err
+		}
+		layout.Blobs = append(layout.Blobs, blobLayout{
+			Namespace:  hex.EncodeToString(element.Blob.Namespace().Bytes()),
+			TxHash:     fmt.Sprintf("%X", tmtypes.Tx(txs[len(builder.Txs)+element.PfbIndex]).Hash()),
+			BlobIndex:  element.BlobIndex,
+			Start:      int(builder.Pfbs[element.PfbIndex].ShareIndexes[element.BlobIndex]),
+			Shares:     element.NumShares,
+			Commitment: base64.StdEncoding.EncodeToString(commitment),
+		})
+	}
+	return layout, nil
+}
+
+// namespaceKind returns the kind of the shares of the namespace.
+func namespaceKind(namespace share.Namespace) string {
+	switch {
+	case namespace.Equals(share.TxNamespace):
+		return "tx"
+	case namespace.Equals(share.PayForBlobNamespace):
+		return "pfb"
+	case namespace.Equals(share.PrimaryReservedPaddingNamespace):
+		return "primary reserved padding"
+	case namespace.Equals(share.TailPaddingNamespace):
+		return "tail padding"
+	case namespace.IsReserved():
+		return "reserved"
+	default:
+		return "blob"
+	}
+}
+
+// printSquareLayout prints the layout as tables.
+func printSquareLayout(w io.Writer, layout squareLayout) error {
+	fmt.Fprintf(w, "height: %d\nsquare size: %d\nutilization: %d/%d shares (%.2f%%)\n\n",
+		layout.Height, layout.SquareSize, layout.UsedShares, layout.TotalShares, 100*layout.Utilization)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAMESPACE\tKIND\tSTART\tEND\tSHARES\tPADDING")
+	for _, ns := range layout.Namespaces {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%d\n", ns.Namespace, ns.Kind, ns.Start, ns.End, ns.End-ns.Start, ns.PaddingShares)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if len(layout.Blobs) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(w)
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAMESPACE\tTX HASH\tBLOB INDEX\tSTART\tSHARES\tCOMMITMENT")
+	for _, blob := range layout.Blobs {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%s\n", blob.Namespace, blob.TxHash, blob.BlobIndex, blob.Start, blob.Shares, blob.Commitment)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestSquareLayout(t *testing.T) {
+	block, blobTxs := newDebugTestBlock(t)
+
+	layout, err := newSquareLayout(block)
+	require.NoError(t, err)
+	assert.Equal(t, int(block.Data.SquareSize), layout.SquareSize)
+	assert.Equal(t, layout.SquareSize*layout.SquareSize, layout.TotalShares)
+
+	// the namespace ranges cover the whole square
+	kinds := make(map[string]int)
+	end := 0
+	for _, ns := range layout.Namespaces {
+		assert.Equal(t, end, ns.Start)
+		end = ns.End
+		kinds[ns.Kind]++
+	}
+	assert.Equal(t, layout.TotalShares, end)
+	assert.Equal(t, 1, kinds["tx"])
+	assert.Equal(t, 1, kinds["pfb"])
+	assert.Equal(t, 2, kinds["blob"])
+	assert.Equal(t, 1, kinds["tail padding"])
+	assert.Greater(t, layout.Utilization, 0.0)
+	assert.Less(t, layout.Utilization, 1.0)
+
+	txs := block.Data.Txs.ToSliceOfBytes()
+	require.Len(t, layout.Blobs, len(blobTxs))
+	for _, blob := range layout.Blobs {
+		txIndex := -1
+		for i, tx := range block.Data.Txs {
+			if fmt.Sprintf("%X", tx.Hash()) == blob.TxHash {
+				txIndex = i
+			}
+		}
+		require.NotEqual(t, -1, txIndex)
+		blobRange, err := square.BlobShareRange(txs, txIndex, blob.BlobIndex, appconsts.DefaultGovMaxSquareSize, appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+		require.NoError(t, err)
+		assert.Equal(t, blobRange.Start, blob.Start)
+		assert.Equal(t, blobRange.End-blobRange.Start, blob.Shares)
+		commitment, err := base64.StdEncoding.DecodeString(blob.Commitment)
+		require.NoError(t, err)
+		assert.Len(t, commitment, 32)
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, printSquareLayout(&buf, layout))
+	assert.Contains(t, buf.String(), "tail padding")
+	assert.Contains(t, buf.String(), layout.Blobs[0].Commitment)
+
+	block.Header.DataHash = tmrand.Bytes(32)
+	_, err = newSquareLayout(block)
+	assert.Error(t, err)
+}
+
+// newDebugTestBlock returns a block containing random transactions and blob
+// transactions with blobs in two namespaces, along with the blob transactions.
+func newDebugTestBlock(t *testing.T) (*tmtypes.Block, [][]byte) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	namespaces := []share.Namespace{
+		share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize)),
+		share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize)),
+	}
+	blobTxs := tmtypes.Txs(blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, namespaces, []int{5000, 2000})).ToSliceOfBytes()
+	txs := append(testfactory.GenerateRandomTxs(20, 500).ToSliceOfBytes(), blobTxs...)
+
+	dataSquare, err := square.Construct(txs, appconsts.DefaultGovMaxSquareSize, appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	block := &tmtypes.Block{
+		Header: tmtypes.Header{
+			Version:  tmversion.Consensus{App: appconsts.LatestVersion},
+			Height:   10,
+			DataHash: dah.Hash(),
+		},
+		Data: tmtypes.Data{Txs: tmtypes.ToTxs(txs), SquareSize: uint64(dataSquare.Size())},
+	}
+	return block, blobTxs
+}
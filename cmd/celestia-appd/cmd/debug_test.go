@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeProofCmd(t *testing.T) {
+	rowProof := proof.RowProof{
+		RowRoots: [][]byte{{1, 2, 3}, {4, 5, 6}},
+		Proofs: []*proof.Proof{
+			{Total: 8, Index: 2, Aunts: [][]byte{{7}, {8}}},
+			{Total: 8, Index: 3, Aunts: [][]byte{{9}, {10}}},
+		},
+		StartRow: 2,
+		EndRow:   3,
+	}
+	jsonBz, err := rowProof.MarshalCanonicalJSON()
+	require.NoError(t, err)
+	compactBz, err := rowProof.MarshalCompact()
+	require.NoError(t, err)
+	protoBz, err := rowProof.Marshal()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		data []byte
+		args []string
+	}{
+		{
+			name: "json",
+			data: jsonBz,
+		},
+		{
+			name: "compact",
+			data: compactBz,
+		},
+		{
+			name: "hex-encoded compact",
+			data: []byte(hex.EncodeToString(compactBz) + "\n"),
+		},
+		{
+			name: "protobuf",
+			data: protoBz,
+			args: []string{"--type", "row"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "proof")
+			require.NoError(t, os.WriteFile(file, tt.data, 0o600))
+
+			output, err := executeCmd(decodeProofCmd(), append(tt.args, file)...)
+			require.NoError(t, err)
+			var decoded proof.RowProof
+			require.NoError(t, decoded.UnmarshalCanonicalJSON([]byte(output)))
+			assert.Equal(t, rowProof, decoded)
+		})
+	}
+
+	t.Run("protobuf without type", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "proof")
+		require.NoError(t, os.WriteFile(file, protoBz, 0o600))
+		_, err := executeCmd(decodeProofCmd(), file)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// exportEDSCmd returns a command that writes the extended data square of a
+// block to a file in the EDS file format.
+func exportEDSCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-eds [height] [file]",
+		Short: "Export the extended data square of a block to a file",
+		Long: `Reads the block at the given height from the node, reconstructs its extended data square and writes it to the file in the EDS file format.
+The file contains the data availability header of the square followed by its shares, see pkg/da for the format and to read it back.`,
+		Example: "celestia-appd debug export-eds 100 block-100.eds --node tcp://localhost:26657\n",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			res, err := node.Block(cmd.Context(), &height)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Create(args[1])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			if err := writeBlockEDS(file, res.Block); err != nil {
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "exported the extended data square of block %d to %s\n", height, args[1])
+			return err
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// writeBlockEDS reconstructs the extended data square of the block and writes
+// it to w in the EDS file format.
+func writeBlockEDS(w io.Writer, block *tmtypes.Block) error {
+	eds, err := blockEDS(block)
+	if err != nil {
+		return err
+	}
+	return da.WriteEDS(w, eds)
+}
+
+// blockEDS reconstructs the extended data square of the block and checks it
+// against the data root of the block.
+func blockEDS(block *tmtypes.Block) (*rsmt2d.ExtendedDataSquare, error) {
+	_, _, eds, err := blockSquare(block)
+	return eds, err
+}
+
+// blockSquare reconstructs the data square of the block along with the builder
+// used to construct it and its extended data square, and checks it against the
+// data root of the block.
+func blockSquare(block *tmtypes.Block) (*square.Builder, square.Square, *rsmt2d.ExtendedDataSquare, error) {
+	if block == nil {
+		return nil, nil, nil, errors.New("nil block")
+	}
+	// the square layout only depends on its size so the upper bound is used
+	// as the max square size as the governance one isn't known.
+	appVersion := block.Header.Version.App
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion), block.Data.Txs.ToSliceOfBytes()...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !bytes.Equal(dah.Hash(), block.Header.DataHash) {
+		return nil, nil, nil, fmt.Errorf("constructed data root %X differs from the data root %X of block %d", dah.Hash(), block.Header.DataHash, block.Height)
+	}
+	return builder, dataSquare, eds, nil
+}
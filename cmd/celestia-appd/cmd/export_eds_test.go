@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestWriteBlockEDS(t *testing.T) {
+	block, _ := newDebugTestBlock(t)
+	dataSquare, err := square.Construct(block.Data.Txs.ToSliceOfBytes(), appconsts.DefaultGovMaxSquareSize, appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeBlockEDS(&buf, block))
+	got, gotDAH, err := da.ReadEDS(&buf)
+	require.NoError(t, err)
+	assert.True(t, eds.Equals(got))
+	assert.Equal(t, []byte(block.Header.DataHash), gotDAH.Hash())
+
+	// the block data doesn't match its data root
+	block.Header.DataHash = tmrand.Bytes(32)
+	assert.Error(t, writeBlockEDS(&bytes.Buffer{}, block))
+	assert.Error(t, writeBlockEDS(&bytes.Buffer{}, nil))
+}
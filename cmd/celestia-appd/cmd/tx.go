@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 )
 
@@ -29,7 +31,20 @@ func txCommand() *cobra.Command {
 	)
 
 	app.ModuleBasics.AddTxCommands(command)
+	addGovSimulateCommand(command)
 	command.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
 
 	return command
 }
+
+// addGovSimulateCommand registers the paramfilter simulate-param-change
+// command under the gov tx command so that it sits next to the commands used to
+// submit proposals.
+func addGovSimulateCommand(txCmd *cobra.Command) {
+	for _, cmd := range txCmd.Commands() {
+		if cmd.Name() == govtypes.ModuleName {
+			cmd.AddCommand(paramfilter.CmdSimulateParamChange())
+			return
+		}
+	}
+}
@@ -0,0 +1,7 @@
+package v3
+
+const (
+	Version              uint64 = 3
+	SquareSizeUpperBound int    = 128
+	SubtreeRootThreshold int    = 64
+)
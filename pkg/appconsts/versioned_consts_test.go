@@ -9,6 +9,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	v1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
 	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
 )
 
 func TestSubtreeRootThreshold(t *testing.T) {
@@ -24,6 +25,10 @@ func TestSubtreeRootThreshold(t *testing.T) {
 			version:  v2.Version,
 			expected: v2.SubtreeRootThreshold,
 		},
+		{
+			version:  v3.Version,
+			expected: v3.SubtreeRootThreshold,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -48,6 +53,10 @@ func TestSquareSizeUpperBound(t *testing.T) {
 			version:  v2.Version,
 			expected: v2.SquareSizeUpperBound,
 		},
+		{
+			version:  v3.Version,
+			expected: v3.SquareSizeUpperBound,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,240 @@
+package da
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// BadEncodingProof (BEFP) is a fraud proof showing that a row or column of an
+// extended data square, committed to by a data availability header, was not
+// built correctly by the proposer: either its shares are not a valid erasure
+// coded codeword, or its codeword can't be committed to by a valid NMT, e.g.
+// because the namespaces of its shares are out of order.
+//
+// The proof contains half of the shares of the axis, each one proven against
+// the root of the orthogonal axis it belongs to. They are enough to recover the
+// codeword of the axis and recompute its root, which doesn't match the one of
+// the header.
+type BadEncodingProof struct {
+	// Axis is the type of the incorrectly built axis.
+	Axis rsmt2d.Axis `json:"axis"`
+	// Index is the index of the incorrectly built axis.
+	Index uint `json:"index"`
+	// Shares are the shares of the axis used to recover its codeword.
+	Shares []AxisShare `json:"shares"`
+}
+
+// AxisShare is a share of the axis of a BadEncodingProof.
+type AxisShare struct {
+	// Index is the index of the share in the axis, i.e. the index of the
+	// orthogonal axis the share belongs to.
+	Index uint   `json:"index"`
+	Share []byte `json:"share"`
+	// Proof is the proof of inclusion of the share in the root of the
+	// orthogonal axis.
+	Proof nmt.Proof `json:"proof"`
+}
+
+// NewBadEncodingProof looks for a row or column of the extended data square
+// that wasn't built correctly and returns the fraud proof for it. The data
+// availability header must be the one committing to the square. It returns an
+// error if all the rows and columns are built correctly.
+func NewBadEncodingProof(eds *rsmt2d.ExtendedDataSquare, dah *DataAvailabilityHeader) (BadEncodingProof, error) {
+	width := eds.Width()
+	if dah == nil || uint(len(dah.RowRoots)) != width || uint(len(dah.ColumnRoots)) != width {
+		return BadEncodingProof{}, errors.New("the data availability header doesn't match the extended data square")
+	}
+	// the parity halves are tried first as their orthogonal axes only contain
+	// parity shares, so the proofs of their shares can be built even if the
+	// namespaces of the original data are out of order.
+	halves := []uint{width / 2, 0}
+	for _, axis := range []rsmt2d.Axis{rsmt2d.Row, rsmt2d.Col} {
+		trees := newOrthogonalTrees(eds, dah, axis)
+		for index := uint(0); index < width; index++ {
+			for _, start := range halves {
+				befp, err := newBadEncodingProof(eds, trees, axis, index, start)
+				if err != nil {
+					// the shares of this half can't be proven against the
+					// orthogonal roots.
+					continue
+				}
+				if befp.Verify(dah) == nil {
+					return befp, nil
+				}
+			}
+		}
+	}
+	return BadEncodingProof{}, errors.New("all the rows and columns of the extended data square are correctly encoded")
+}
+
+// newBadEncodingProof returns the candidate proof for the axis using the half
+// of its shares starting at start.
+func newBadEncodingProof(eds *rsmt2d.ExtendedDataSquare, trees *orthogonalTrees, axis rsmt2d.Axis, index, start uint) (BadEncodingProof, error) {
+	width := eds.Width()
+	befp := BadEncodingProof{
+		Axis:   axis,
+		Index:  index,
+		Shares: make([]AxisShare, 0, width/2),
+	}
+	for i := start; i < start+width/2; i++ {
+		tree, err := trees.tree(i)
+		if err != nil {
+			return BadEncodingProof{}, err
+		}
+		proof, err := tree.ProveRange(int(index), int(index)+1)
+		if err != nil {
+			return BadEncodingProof{}, err
+		}
+		axisShare := eds.GetCell(index, i)
+		if axis == rsmt2d.Col {
+			axisShare = eds.GetCell(i, index)
+		}
+		befp.Shares = append(befp.Shares, AxisShare{
+			Index: i,
+			Share: axisShare,
+			Proof: proof,
+		})
+	}
+	return befp, nil
+}
+
+// orthogonalTrees builds the trees of the axes orthogonal to an axis of the
+// extended data square on first use and checks them against their roots, so
+// that each tree is built once for all the candidate proofs of the axis.
+type orthogonalTrees struct {
+	eds   *rsmt2d.ExtendedDataSquare
+	dah   *DataAvailabilityHeader
+	axis  rsmt2d.Axis
+	trees []*wrapper.ErasuredNamespacedMerkleTree
+	errs  []error
+}
+
+func newOrthogonalTrees(eds *rsmt2d.ExtendedDataSquare, dah *DataAvailabilityHeader, axis rsmt2d.Axis) *orthogonalTrees {
+	return &orthogonalTrees{
+		eds:   eds,
+		dah:   dah,
+		axis:  axis,
+		trees: make([]*wrapper.ErasuredNamespacedMerkleTree, eds.Width()),
+		errs:  make([]error, eds.Width()),
+	}
+}
+
+// tree returns the tree of the orthogonal axis at index, or an error if it
+// can't be built or doesn't match its root.
+func (t *orthogonalTrees) tree(index uint) (*wrapper.ErasuredNamespacedMerkleTree, error) {
+	if t.trees[index] == nil && t.errs[index] == nil {
+		t.trees[index], t.errs[index] = t.build(index)
+	}
+	return t.trees[index], t.errs[index]
+}
+
+func (t *orthogonalTrees) build(index uint) (*wrapper.ErasuredNamespacedMerkleTree, error) {
+	orthogonal, orthogonalRoot := orthogonalAxis(t.axis), t.dah.ColumnRoots[index]
+	if orthogonal == rsmt2d.Row {
+		orthogonalRoot = t.dah.RowRoots[index]
+	}
+	tree, err := wrapper.NewEDSAxisTree(t.eds, orthogonal, index)
+	if err != nil {
+		return nil, err
+	}
+	root, err := tree.Root()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(root, orthogonalRoot) {
+		return nil, fmt.Errorf("%s %d doesn't match its root", orthogonal, index)
+	}
+	return tree, nil
+}
+
+// Verify verifies that the axis of the proof, committed to by the data
+// availability header, was not built correctly. It returns nil if the proof is
+// valid, i.e. if the fraud is proven.
+func (p BadEncodingProof) Verify(dah *DataAvailabilityHeader) error {
+	if dah == nil {
+		return errors.New("nil data availability header")
+	}
+	width := uint(len(dah.RowRoots))
+	if uint(len(dah.ColumnRoots)) != width || width == 0 {
+		return errors.New("invalid data availability header")
+	}
+	if p.Axis != rsmt2d.Row && p.Axis != rsmt2d.Col {
+		return fmt.Errorf("invalid axis %d", p.Axis)
+	}
+	if p.Index >= width {
+		return fmt.Errorf("%s %d out of the extended data square of width %d", p.Axis, p.Index, width)
+	}
+	if uint(len(p.Shares)) != width/2 {
+		return fmt.Errorf("the proof has %d shares instead of %d", len(p.Shares), width/2)
+	}
+
+	shares := make([][]byte, width)
+	for _, s := range p.Shares {
+		if s.Index >= width {
+			return fmt.Errorf("share %d out of the %s of width %d", s.Index, p.Axis, width)
+		}
+		if shares[s.Index] != nil {
+			return fmt.Errorf("duplicate share %d", s.Index)
+		}
+		if len(s.Share) != share.ShareSize {
+			return fmt.Errorf("share %d has size %d instead of %d", s.Index, len(s.Share), share.ShareSize)
+		}
+		orthogonalRoot := dah.ColumnRoots[s.Index]
+		row, col := p.Index, s.Index
+		if p.Axis == rsmt2d.Col {
+			orthogonalRoot = dah.RowRoots[s.Index]
+			row, col = s.Index, p.Index
+		}
+		// the shares outside of the original data square are parity shares
+		// so the wrapper pushes them with the parity shares namespace.
+		namespace := share.ParitySharesNamespace.Bytes()
+		if row < width/2 && col < width/2 {
+			namespace = s.Share[:share.NamespaceSize]
+		}
+		if s.Proof.Start() != int(p.Index) || s.Proof.End() != int(p.Index)+1 {
+			return fmt.Errorf("proof of share %d is for the range [%d, %d) instead of %d", s.Index, s.Proof.Start(), s.Proof.End(), p.Index)
+		}
+		if !s.Proof.VerifyInclusion(appconsts.NewBaseHashFunc(), namespace, [][]byte{s.Share}, orthogonalRoot) {
+			return fmt.Errorf("share %d is not included in the root of %s %d", s.Index, orthogonalAxis(p.Axis), s.Index)
+		}
+		shares[s.Index] = s.Share
+	}
+
+	// recover the codeword of the axis from the proven shares and check that
+	// it is not the one committed to by the header.
+	codeword, err := appconsts.DefaultCodec().Decode(shares)
+	if err != nil {
+		return fmt.Errorf("recovering %s %d: %w", p.Axis, p.Index, err)
+	}
+	tree, err := wrapper.NewAxisTree(codeword, p.Index)
+	if err != nil {
+		// the codeword can't be committed to by a valid NMT.
+		return nil
+	}
+	root, err := tree.Root()
+	if err != nil {
+		return err
+	}
+	axisRoot := dah.RowRoots[p.Index]
+	if p.Axis == rsmt2d.Col {
+		axisRoot = dah.ColumnRoots[p.Index]
+	}
+	if bytes.Equal(root, axisRoot) {
+		return fmt.Errorf("%s %d is correctly encoded", p.Axis, p.Index)
+	}
+	return nil
+}
+
+func orthogonalAxis(axis rsmt2d.Axis) rsmt2d.Axis {
+	if axis == rsmt2d.Row {
+		return rsmt2d.Col
+	}
+	return rsmt2d.Row
+}
@@ -0,0 +1,143 @@
+package da_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/malicious"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBadEncodingProofOutOfOrder(t *testing.T) {
+	txs := newBadEncodingTestTxs(t)
+	dataSquare, err := malicious.Construct(txs, appconsts.LatestVersion, appconsts.SquareSizeUpperBound(appconsts.LatestVersion), malicious.OutOfOrderExport)
+	require.NoError(t, err)
+	eds, err := malicious.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	befp, err := da.NewBadEncodingProof(eds, &dah)
+	require.NoError(t, err)
+	assert.NoError(t, befp.Verify(&dah))
+	assert.Len(t, befp.Shares, int(eds.Width()/2))
+}
+
+func TestBadEncodingProofInvalidParity(t *testing.T) {
+	eds, dah, row := newBadParityTestSquare(t)
+
+	befp, err := da.NewBadEncodingProof(eds, &dah)
+	require.NoError(t, err)
+	assert.NoError(t, befp.Verify(&dah))
+	// the first incorrectly encoded axis is the row of the modified share
+	assert.Equal(t, rsmt2d.Row, befp.Axis)
+	assert.Equal(t, row, befp.Index)
+}
+
+func TestBadEncodingProofCorrectSquare(t *testing.T) {
+	dataSquare, err := square.Construct(newBadEncodingTestTxs(t), appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	_, err = da.NewBadEncodingProof(eds, &dah)
+	assert.Error(t, err)
+
+	// a proof for another square doesn't verify against a correct one
+	badEDS, badDAH, _ := newBadParityTestSquare(t)
+	befp, err := da.NewBadEncodingProof(badEDS, &badDAH)
+	require.NoError(t, err)
+	assert.Error(t, befp.Verify(&dah))
+}
+
+func TestBadEncodingProofVerifyInvalid(t *testing.T) {
+	eds, dah, _ := newBadParityTestSquare(t)
+
+	tests := []struct {
+		name   string
+		modify func(p *da.BadEncodingProof)
+	}{
+		{
+			name:   "missing share",
+			modify: func(p *da.BadEncodingProof) { p.Shares = p.Shares[1:] },
+		},
+		{
+			name:   "duplicate share",
+			modify: func(p *da.BadEncodingProof) { p.Shares[1] = p.Shares[0] },
+		},
+		{
+			name:   "modified share",
+			modify: func(p *da.BadEncodingProof) { p.Shares[0].Share[len(p.Shares[0].Share)-1] ^= 0xFF },
+		},
+		{
+			name:   "share of another axis",
+			modify: func(p *da.BadEncodingProof) { p.Index++ },
+		},
+		{
+			name:   "column instead of row",
+			modify: func(p *da.BadEncodingProof) { p.Axis = rsmt2d.Col },
+		},
+		{
+			name:   "invalid axis",
+			modify: func(p *da.BadEncodingProof) { p.Axis = 2 },
+		},
+		{
+			name:   "out of the square",
+			modify: func(p *da.BadEncodingProof) { p.Shares[0].Index = eds.Width() },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			befp, err := da.NewBadEncodingProof(eds, &dah)
+			require.NoError(t, err)
+			require.NoError(t, befp.Verify(&dah))
+			tt.modify(&befp)
+			assert.Error(t, befp.Verify(&dah))
+		})
+	}
+}
+
+// newBadParityTestSquare returns an extended data square in which a parity
+// share was modified after the extension, along with its data availability
+// header and the row of the modified share.
+func newBadParityTestSquare(t *testing.T) (*rsmt2d.ExtendedDataSquare, da.DataAvailabilityHeader, uint) {
+	dataSquare, err := square.Construct(newBadEncodingTestTxs(t), appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+
+	width := eds.Width()
+	row, col := uint(1), width/2+1
+	shares := eds.Flattened()
+	shares[row*width+col] = bytes.Repeat([]byte{0xAB}, share.ShareSize)
+	badEDS, err := rsmt2d.ImportExtendedDataSquare(shares, appconsts.DefaultCodec(), wrapper.NewConstructor(uint64(width/2)))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(badEDS)
+	require.NoError(t, err)
+	return badEDS, dah, row
+}
+
+// newBadEncodingTestTxs returns random transactions and blob transactions with
+// blobs in two namespaces.
+func newBadEncodingTestTxs(t *testing.T) [][]byte {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	namespaces := []share.Namespace{
+		share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize)),
+		share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize)),
+	}
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, namespaces, []int{5000, 5000})
+	return append(testfactory.GenerateRandomTxs(20, 500), blobTxs...).ToSliceOfBytes()
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/celestiaorg/go-square/v2"
 	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/nmt"
 	"github.com/celestiaorg/rsmt2d"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/types"
@@ -64,6 +65,14 @@ func NewDataAvailabilityHeader(eds *rsmt2d.ExtendedDataSquare) (DataAvailability
 }
 
 func ExtendShares(s [][]byte) (*rsmt2d.ExtendedDataSquare, error) {
+	return ExtendSharesWithTreeOptions(s)
+}
+
+// ExtendSharesWithTreeOptions extends the shares like ExtendShares but builds
+// the row and column NMTs with the given options. For example,
+// wrapper.WithHashFunc can be used to compute the roots of the square with
+// another base hash function than the one used by consensus.
+func ExtendSharesWithTreeOptions(s [][]byte, opts ...nmt.Option) (*rsmt2d.ExtendedDataSquare, error) {
 	// Check that the length of the square is a power of 2.
 	if !square.IsPowerOfTwo(len(s)) {
 		return nil, fmt.Errorf("number of shares is not a power of 2: got %d", len(s))
@@ -72,7 +81,7 @@ func ExtendShares(s [][]byte) (*rsmt2d.ExtendedDataSquare, error) {
 
 	// here we construct a tree
 	// Note: uses the nmt wrapper to construct the tree.
-	return rsmt2d.ComputeExtendedDataSquare(s, appconsts.DefaultCodec(), wrapper.NewConstructor(uint64(squareSize)))
+	return rsmt2d.ComputeExtendedDataSquare(s, appconsts.DefaultCodec(), wrapper.NewConstructor(uint64(squareSize), opts...))
 }
 
 // String returns hex representation of merkle hash of the DAHeader.
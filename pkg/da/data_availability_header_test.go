@@ -2,11 +2,13 @@ package da
 
 import (
 	"bytes"
+	"encoding/hex"
 	"sort"
 	"strings"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
 	sh "github.com/celestiaorg/go-square/v2/share"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -242,6 +244,40 @@ func TestSquareSize(t *testing.T) {
 	}
 }
 
+func TestExtendSharesWithTreeOptions(t *testing.T) {
+	testCases := []struct {
+		hashFunc     string
+		expectedHash string
+	}{
+		{
+			hashFunc:     wrapper.HashFuncSHA256,
+			expectedHash: "b56e4d251ac266f4b91cc5464b3fc7efcbdc888064647496d13133f0dc65ac25",
+		},
+		{
+			hashFunc:     wrapper.HashFuncMiMC,
+			expectedHash: "04f4aa2863ae8c6d75e0ccec677b1e97f8e9e6ce40bce815b0511b2b894c837d",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.hashFunc, func(t *testing.T) {
+			newHash, err := wrapper.NewHashFunc(tc.hashFunc)
+			require.NoError(t, err)
+			eds, err := ExtendSharesWithTreeOptions(generateShares(4), wrapper.WithHashFunc(newHash))
+			require.NoError(t, err)
+			dah, err := NewDataAvailabilityHeader(eds)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedHash, hex.EncodeToString(dah.Hash()))
+		})
+	}
+
+	// the default hash function is the sha256 one
+	eds, err := ExtendShares(generateShares(4))
+	require.NoError(t, err)
+	dah, err := NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	assert.Equal(t, testCases[0].expectedHash, hex.EncodeToString(dah.Hash()))
+}
+
 // generateShares generates count number of shares with a constant namespace and
 // share contents.
 func generateShares(count int) (shares [][]byte) {
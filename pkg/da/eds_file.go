@@ -0,0 +1,135 @@
+package da
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
+
+	daproto "github.com/celestiaorg/celestia-app/v3/proto/celestia/core/v1/da"
+)
+
+// The EDS file format is a CAR-like format to store an extended data square.
+// It is a sequence of sections, each one prefixed by its length as an unsigned
+// varint. The first section is the protobuf encoded data availability header
+// of the square and the following ones are the shares of the square, row by
+// row. As all the shares have the same size, the share at the coordinates
+// (row, col) can be read directly at the offset
+// headerLen + (row*width + col) * shareSectionLen, both lengths including the
+// length prefix.
+
+// maxEDSHeaderSize is the maximum size of the header section of an EDS file.
+// It is large enough for the data availability header of the largest square.
+const maxEDSHeaderSize = 1 << 20
+
+// WriteEDS writes the extended data square to w in the EDS file format.
+func WriteEDS(w io.Writer, eds *rsmt2d.ExtendedDataSquare) error {
+	dah, err := NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return err
+	}
+	dahp, err := dah.ToProto()
+	if err != nil {
+		return err
+	}
+	header, err := dahp.Marshal()
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	if err := writeEDSSection(bw, header); err != nil {
+		return err
+	}
+	for _, sh := range eds.Flattened() {
+		if err := writeEDSSection(bw, sh); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+func writeEDSSection(w io.Writer, data []byte) error {
+	if _, err := w.Write(binary.AppendUvarint(nil, uint64(len(data)))); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// ReadEDS reads an extended data square in the EDS file format from r. The
+// square is extended again from its original data to check that the parity
+// shares and the roots of the header are the ones of the original data.
+func ReadEDS(r io.Reader) (*rsmt2d.ExtendedDataSquare, DataAvailabilityHeader, error) {
+	br := bufio.NewReader(r)
+	header, err := readEDSSection(br, maxEDSHeaderSize)
+	if err != nil {
+		return nil, DataAvailabilityHeader{}, fmt.Errorf("reading header: %w", err)
+	}
+	var dahp daproto.DataAvailabilityHeader
+	if err := dahp.Unmarshal(header); err != nil {
+		return nil, DataAvailabilityHeader{}, fmt.Errorf("decoding header: %w", err)
+	}
+	dah, err := DataAvailabilityHeaderFromProto(&dahp)
+	if err != nil {
+		return nil, DataAvailabilityHeader{}, fmt.Errorf("invalid header: %w", err)
+	}
+
+	width := len(dah.RowRoots)
+	shares := make([][]byte, 0, width*width)
+	for i := 0; i < width*width; i++ {
+		sh, err := readEDSSection(br, share.ShareSize)
+		if err != nil {
+			return nil, DataAvailabilityHeader{}, fmt.Errorf("reading share %d: %w", i, err)
+		}
+		if len(sh) != share.ShareSize {
+			return nil, DataAvailabilityHeader{}, fmt.Errorf("share %d has size %d instead of %d", i, len(sh), share.ShareSize)
+		}
+		shares = append(shares, sh)
+	}
+	if _, err := br.ReadByte(); err != io.EOF {
+		return nil, DataAvailabilityHeader{}, errors.New("unexpected data after the last share")
+	}
+
+	ods := make([][]byte, 0, width*width/4)
+	for row := 0; row < width/2; row++ {
+		ods = append(ods, shares[row*width:row*width+width/2]...)
+	}
+	eds, err := ExtendShares(ods)
+	if err != nil {
+		return nil, DataAvailabilityHeader{}, err
+	}
+	for i, sh := range eds.Flattened() {
+		if !bytes.Equal(sh, shares[i]) {
+			return nil, DataAvailabilityHeader{}, fmt.Errorf("share (%d, %d) doesn't match the extension of the original data", i/width, i%width)
+		}
+	}
+	computed, err := NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, DataAvailabilityHeader{}, err
+	}
+	if !computed.Equals(dah) {
+		return nil, DataAvailabilityHeader{}, fmt.Errorf("computed data root %X doesn't match the data root %X of the header", computed.Hash(), dah.Hash())
+	}
+	return eds, computed, nil
+}
+
+func readEDSSection(r *bufio.Reader, maxLen uint64) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if n > maxLen {
+		return nil, fmt.Errorf("section length %d exceeds the maximum %d", n, maxLen)
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
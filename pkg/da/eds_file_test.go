@@ -0,0 +1,79 @@
+package da
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEDSFile(t *testing.T) {
+	eds, dah := newSampleTestSquare(t)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteEDS(&buf, eds))
+
+	got, gotDAH, err := ReadEDS(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.True(t, eds.Equals(got))
+	assert.True(t, dah.Equals(&gotDAH))
+
+	// the shares can be read directly at their offset
+	headerLen := len(buf.Bytes()) - int(eds.Width()*eds.Width())*(share.ShareSize+2)
+	width := int(eds.Width())
+	row, col := 3, 5
+	offset := headerLen + (row*width+col)*(share.ShareSize+2)
+	assert.Equal(t, eds.GetCell(uint(row), uint(col)), buf.Bytes()[offset+2:offset+2+share.ShareSize])
+}
+
+func TestReadEDSInvalid(t *testing.T) {
+	eds, _ := newSampleTestSquare(t)
+	var buf bytes.Buffer
+	require.NoError(t, WriteEDS(&buf, eds))
+	file := buf.Bytes()
+	lastShare := len(file) - share.ShareSize
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{
+			name: "empty",
+			data: nil,
+		},
+		{
+			name: "truncated",
+			data: file[:len(file)-1],
+		},
+		{
+			name: "trailing data",
+			data: append(bytes.Clone(file), 0),
+		},
+		{
+			name: "modified parity share",
+			data: func() []byte {
+				data := bytes.Clone(file)
+				data[lastShare+share.NamespaceSize] ^= 0xFF
+				return data
+			}(),
+		},
+		{
+			name: "other header",
+			data: func() []byte {
+				otherEDS, _ := newSampleTestSquare(t)
+				var other bytes.Buffer
+				require.NoError(t, WriteEDS(&other, otherEDS))
+				headerLen := len(file) - int(eds.Width()*eds.Width())*(share.ShareSize+2)
+				return append(other.Bytes()[:headerLen], file[headerLen:]...)
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ReadEDS(bytes.NewReader(tt.data))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package da
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// Sample is a share of an extended data square along with the proofs of its
+// inclusion in the row and column roots of the square. It is what a light node
+// requests when sampling the square at the coordinates (Row, Col).
+type Sample struct {
+	Row   uint   `json:"row"`
+	Col   uint   `json:"col"`
+	Share []byte `json:"share"`
+	// RowProof is the proof of inclusion of the share, at index Col, in the
+	// root of the row Row.
+	RowProof nmt.Proof `json:"row_proof"`
+	// ColProof is the proof of inclusion of the share, at index Row, in the
+	// root of the column Col.
+	ColProof nmt.Proof `json:"col_proof"`
+}
+
+// NewSample returns the sample of the extended data square at the coordinates
+// (row, col).
+func NewSample(eds *rsmt2d.ExtendedDataSquare, row, col uint) (Sample, error) {
+	width := eds.Width()
+	if row >= width || col >= width {
+		return Sample{}, fmt.Errorf("coordinates (%d, %d) out of the extended data square of width %d", row, col, width)
+	}
+	rowProof, err := proveAxisLeaf(eds.Row(row), row, col)
+	if err != nil {
+		return Sample{}, fmt.Errorf("proving share (%d, %d) in its row: %w", row, col, err)
+	}
+	colProof, err := proveAxisLeaf(eds.Col(col), col, row)
+	if err != nil {
+		return Sample{}, fmt.Errorf("proving share (%d, %d) in its column: %w", row, col, err)
+	}
+	return Sample{
+		Row:      row,
+		Col:      col,
+		Share:    eds.GetCell(row, col),
+		RowProof: rowProof,
+		ColProof: colProof,
+	}, nil
+}
+
+// proveAxisLeaf returns the proof of inclusion of the leaf at index in the NMT
+// of the row or column at axisIndex containing the shares.
+func proveAxisLeaf(shares [][]byte, axisIndex, index uint) (nmt.Proof, error) {
+	tree, err := wrapper.NewAxisTree(shares, axisIndex)
+	if err != nil {
+		return nmt.Proof{}, err
+	}
+	return tree.ProveRange(int(index), int(index)+1)
+}
+
+// Verify verifies that the share of the sample is included in both its row and
+// column roots of the data availability header. It returns nil if the sample is
+// valid.
+func (s Sample) Verify(dah *DataAvailabilityHeader) error {
+	if dah == nil {
+		return errors.New("nil data availability header")
+	}
+	width := uint(len(dah.RowRoots))
+	if s.Row >= width || s.Col >= width || len(dah.ColumnRoots) != len(dah.RowRoots) {
+		return fmt.Errorf("coordinates (%d, %d) out of the extended data square of width %d", s.Row, s.Col, width)
+	}
+	if len(s.Share) < share.NamespaceSize {
+		return errors.New("share is too short to contain a namespace")
+	}
+
+	// the shares outside of the original data square are parity shares so the
+	// wrapper pushes them with the parity shares namespace.
+	namespace := share.ParitySharesNamespace.Bytes()
+	if s.Row < width/2 && s.Col < width/2 {
+		namespace = s.Share[:share.NamespaceSize]
+	}
+	if s.RowProof.Start() != int(s.Col) || s.RowProof.End() != int(s.Col)+1 {
+		return fmt.Errorf("row proof is for the range [%d, %d) instead of share %d", s.RowProof.Start(), s.RowProof.End(), s.Col)
+	}
+	if !s.RowProof.VerifyInclusion(appconsts.NewBaseHashFunc(), namespace, [][]byte{s.Share}, dah.RowRoots[s.Row]) {
+		return fmt.Errorf("share (%d, %d) is not included in its row root", s.Row, s.Col)
+	}
+	if s.ColProof.Start() != int(s.Row) || s.ColProof.End() != int(s.Row)+1 {
+		return fmt.Errorf("column proof is for the range [%d, %d) instead of share %d", s.ColProof.Start(), s.ColProof.End(), s.Row)
+	}
+	if !s.ColProof.VerifyInclusion(appconsts.NewBaseHashFunc(), namespace, [][]byte{s.Share}, dah.ColumnRoots[s.Col]) {
+		return fmt.Errorf("share (%d, %d) is not included in its column root", s.Row, s.Col)
+	}
+	return nil
+}
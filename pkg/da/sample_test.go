@@ -0,0 +1,88 @@
+package da
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSample(t *testing.T) {
+	eds, dah := newSampleTestSquare(t)
+
+	for row := uint(0); row < eds.Width(); row++ {
+		for col := uint(0); col < eds.Width(); col++ {
+			sample, err := NewSample(eds, row, col)
+			require.NoError(t, err)
+			assert.Equal(t, eds.GetCell(row, col), sample.Share)
+			assert.NoError(t, sample.Verify(&dah))
+		}
+	}
+
+	_, err := NewSample(eds, eds.Width(), 0)
+	assert.Error(t, err)
+	_, err = NewSample(eds, 0, eds.Width())
+	assert.Error(t, err)
+}
+
+func TestSampleVerifyInvalid(t *testing.T) {
+	eds, dah := newSampleTestSquare(t)
+
+	tests := []struct {
+		name   string
+		modify func(s *Sample)
+	}{
+		{
+			name:   "modified share",
+			modify: func(s *Sample) { s.Share[len(s.Share)-1] ^= 0xFF },
+		},
+		{
+			name:   "other row",
+			modify: func(s *Sample) { s.Row++ },
+		},
+		{
+			name:   "other column",
+			modify: func(s *Sample) { s.Col++ },
+		},
+		{
+			name:   "out of the square",
+			modify: func(s *Sample) { s.Row = eds.Width() },
+		},
+		{
+			name:   "swapped proofs",
+			modify: func(s *Sample) { s.RowProof, s.ColProof = s.ColProof, s.RowProof },
+		},
+		{
+			name:   "short share",
+			modify: func(s *Sample) { s.Share = s.Share[:1] },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sample, err := NewSample(eds, 1, 2)
+			require.NoError(t, err)
+			require.NoError(t, sample.Verify(&dah))
+			tt.modify(&sample)
+			assert.Error(t, sample.Verify(&dah))
+		})
+	}
+
+	// the sample doesn't verify against another square
+	sample, err := NewSample(eds, 1, 2)
+	require.NoError(t, err)
+	_, otherDAH := newSampleTestSquare(t)
+	assert.Error(t, sample.Verify(&otherDAH))
+	assert.Error(t, sample.Verify(nil))
+}
+
+// newSampleTestSquare returns an extended data square of random shares and its
+// data availability header.
+func newSampleTestSquare(t *testing.T) (*rsmt2d.ExtendedDataSquare, DataAvailabilityHeader) {
+	eds, err := ExtendShares(testfactory.GenerateRandNamespacedRawData(16))
+	require.NoError(t, err)
+	dah, err := NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	return eds, dah
+}
@@ -10,21 +10,34 @@ import (
 // GetCommitment gets the share commitment for a blob in the original data
 // square.
 func GetCommitment(cacher *EDSSubTreeRootCacher, dah da.DataAvailabilityHeader, start, blobShareLen, subtreeRootThreshold int) ([]byte, error) {
+	subTreeRoots, _, err := GetSubtreeRoots(cacher, dah, start, blobShareLen, subtreeRootThreshold)
+	if err != nil {
+		return nil, err
+	}
+	return merkle.HashFromByteSlices(subTreeRoots), nil
+}
+
+// GetSubtreeRoots gets the subtree roots used to compute the share commitment
+// for a blob in the original data square, in the order they are committed to,
+// along with the row that contains each of them.
+func GetSubtreeRoots(cacher *EDSSubTreeRootCacher, dah da.DataAvailabilityHeader, start, blobShareLen, subtreeRootThreshold int) (subTreeRoots [][]byte, rows []int, err error) {
 	squareSize := len(dah.RowRoots) / 2
 	if start+blobShareLen > squareSize*squareSize {
-		return nil, errors.New("cannot get commitment for blob that doesn't fit in square")
+		return nil, nil, errors.New("cannot get commitment for blob that doesn't fit in square")
 	}
 	paths := calculateCommitmentPaths(squareSize, start, blobShareLen, subtreeRootThreshold)
-	subTreeRoots := make([][]byte, len(paths))
+	subTreeRoots = make([][]byte, len(paths))
+	rows = make([]int, len(paths))
 	for i, path := range paths {
 		// here we prepend false (walk left down the tree) because we only need
 		// the subtree roots from the original data square.
 		orignalSquarePath := append(append(make([]WalkInstruction, 0, len(path.instructions)+1), WalkLeft), path.instructions...)
 		subTreeRoot, err := cacher.getSubTreeRoot(dah, path.row, orignalSquarePath)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		subTreeRoots[i] = subTreeRoot
+		rows[i] = path.row
 	}
-	return merkle.HashFromByteSlices(subTreeRoots), nil
+	return subTreeRoots, rows, nil
 }
@@ -0,0 +1,104 @@
+package proof
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+// Verify checks that the shares in the bundle are included in the bundle's
+// data root and that the (height, data root) tuple is included in the given
+// data commitment root. It returns nil if the bundle is valid.
+func (b ProofBundle) Verify(dataCommitmentRoot []byte) error {
+	if b.Height <= 0 {
+		return fmt.Errorf("height %d must be positive", b.Height)
+	}
+	if uint64(b.Height) < b.BeginBlock || uint64(b.Height) >= b.EndBlock {
+		return fmt.Errorf("height %d is not within the data commitment range [%d, %d)", b.Height, b.BeginBlock, b.EndBlock)
+	}
+	if len(b.DataRoot) != 32 {
+		return fmt.Errorf("data root must be 32 bytes, got %d", len(b.DataRoot))
+	}
+	if b.ShareProof == nil || b.ShareProof.RowProof == nil {
+		return errors.New("missing share proof")
+	}
+	if b.DataRootTupleProof == nil {
+		return errors.New("missing data root tuple proof")
+	}
+
+	if err := b.ShareProof.Validate(b.DataRoot); err != nil {
+		return fmt.Errorf("shares are not included in the data root: %w", err)
+	}
+
+	tuple := EncodeDataRootTuple(uint64(b.Height), *(*[32]byte)(b.DataRoot))
+	if err := b.DataRootTupleProof.Verify(dataCommitmentRoot, tuple); err != nil {
+		return fmt.Errorf("data root tuple is not included in the data commitment root: %w", err)
+	}
+	return nil
+}
+
+// EncodeDataRootTuple encodes a (height, data root) tuple the same way as
+// Blobstream does when it builds the data root tuple root. The height is left
+// padded to 32 bytes and followed by the data root.
+func EncodeDataRootTuple(height uint64, dataRoot [32]byte) []byte {
+	tuple := make([]byte, 64)
+	binary.BigEndian.PutUint64(tuple[24:32], height)
+	copy(tuple[32:], dataRoot[:])
+	return tuple
+}
+
+// ShareProofFromTendermint converts a share proof returned by the Tendermint
+// RPC into a ShareProof.
+func ShareProofFromTendermint(sp types.ShareProof) ShareProof {
+	shareProofs := make([]*NMTProof, len(sp.ShareProofs))
+	for i, p := range sp.ShareProofs {
+		shareProofs[i] = nmtProofFromTendermint(p)
+	}
+	rowRoots := make([][]byte, len(sp.RowProof.RowRoots))
+	for i, root := range sp.RowProof.RowRoots {
+		rowRoots[i] = root
+	}
+	rowProofs := make([]*Proof, len(sp.RowProof.Proofs))
+	for i, p := range sp.RowProof.Proofs {
+		rowProofs[i] = ProofFromMerkle(*p)
+	}
+
+	return ShareProof{
+		Data:        sp.Data,
+		ShareProofs: shareProofs,
+		NamespaceId: sp.NamespaceID,
+		RowProof: &RowProof{
+			RowRoots: rowRoots,
+			Proofs:   rowProofs,
+			StartRow: sp.RowProof.StartRow,
+			EndRow:   sp.RowProof.EndRow,
+		},
+		NamespaceVersion: sp.NamespaceVersion,
+	}
+}
+
+// ProofFromMerkle converts a merkle.Proof into a Proof.
+func ProofFromMerkle(p merkle.Proof) *Proof {
+	return &Proof{
+		Total:    p.Total,
+		Index:    p.Index,
+		LeafHash: p.LeafHash,
+		Aunts:    p.Aunts,
+	}
+}
+
+func nmtProofFromTendermint(p *tmproto.NMTProof) *NMTProof {
+	if p == nil {
+		return nil
+	}
+	return &NMTProof{
+		Start:    p.Start,
+		End:      p.End,
+		Nodes:    p.Nodes,
+		LeafHash: p.LeafHash,
+	}
+}
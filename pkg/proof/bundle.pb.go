@@ -0,0 +1,599 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/proof/bundle.proto
+
+package proof
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProofBundle is a self-contained proof that a set of shares was committed to
+// by a Blobstream data commitment. It can be verified offline against the data
+// commitment root that the Blobstream contract stores for the nonce.
+type ProofBundle struct {
+	// Height is the height of the block that contains the shares.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// DataRoot is the data root of the block that contains the shares.
+	DataRoot []byte `protobuf:"bytes,2,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	// ShareProof proves that the shares are included in the data root.
+	ShareProof *ShareProof `protobuf:"bytes,3,opt,name=share_proof,json=shareProof,proto3" json:"share_proof,omitempty"`
+	// Nonce is the nonce of the data commitment that covers the block.
+	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// BeginBlock is the first block covered by the data commitment.
+	BeginBlock uint64 `protobuf:"varint,5,opt,name=begin_block,json=beginBlock,proto3" json:"begin_block,omitempty"`
+	// EndBlock is the end exclusive last block covered by the data commitment.
+	EndBlock uint64 `protobuf:"varint,6,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	// DataRootTupleProof proves that the (height, data root) tuple is included
+	// in the data commitment root.
+	DataRootTupleProof *Proof `protobuf:"bytes,7,opt,name=data_root_tuple_proof,json=dataRootTupleProof,proto3" json:"data_root_tuple_proof,omitempty"`
+}
+
+func (m *ProofBundle) Reset()         { *m = ProofBundle{} }
+func (m *ProofBundle) String() string { return proto.CompactTextString(m) }
+func (*ProofBundle) ProtoMessage()    {}
+func (*ProofBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09560e4792c3627c, []int{0}
+}
+func (m *ProofBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProofBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProofBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofBundle.Merge(m, src)
+}
+func (m *ProofBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofBundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofBundle proto.InternalMessageInfo
+
+func (m *ProofBundle) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ProofBundle) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func (m *ProofBundle) GetShareProof() *ShareProof {
+	if m != nil {
+		return m.ShareProof
+	}
+	return nil
+}
+
+func (m *ProofBundle) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *ProofBundle) GetBeginBlock() uint64 {
+	if m != nil {
+		return m.BeginBlock
+	}
+	return 0
+}
+
+func (m *ProofBundle) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+func (m *ProofBundle) GetDataRootTupleProof() *Proof {
+	if m != nil {
+		return m.DataRootTupleProof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ProofBundle)(nil), "celestia.core.v1.proof.ProofBundle")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/proof/bundle.proto", fileDescriptor_09560e4792c3627c)
+}
+
+var fileDescriptor_09560e4792c3627c = []byte{
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0xc6, 0xeb, 0xfe, 0xa3, 0x38, 0x4c, 0x16, 0x54, 0x11, 0x88, 0x10, 0x95, 0x25, 0x4b, 0x6d,
+	0x15, 0xde, 0xa0, 0x0c, 0xac, 0x55, 0x60, 0x62, 0x89, 0xf2, 0xc7, 0x24, 0x51, 0x83, 0xcf, 0x72,
+	0xdd, 0x3e, 0x07, 0x2f, 0xc2, 0x7b, 0x30, 0x76, 0x64, 0x44, 0xed, 0x8b, 0x20, 0xdb, 0x4d, 0x27,
+	0xba, 0x44, 0x77, 0xf7, 0x7d, 0x77, 0xf9, 0xf9, 0xc3, 0xf7, 0x39, 0x6f, 0xf8, 0x4a, 0xd7, 0x29,
+	0xcb, 0x41, 0x71, 0xb6, 0x99, 0x31, 0xa9, 0x00, 0xde, 0x59, 0xb6, 0x16, 0x45, 0xc3, 0xa9, 0x54,
+	0xa0, 0x81, 0x8c, 0x5b, 0x13, 0x35, 0x26, 0xba, 0x99, 0x51, 0x6b, 0xba, 0x9e, 0x9c, 0x58, 0xb6,
+	0x5f, 0xb7, 0x3b, 0xf9, 0xea, 0x62, 0x6f, 0x61, 0xfa, 0xb9, 0xbd, 0x48, 0xc6, 0x78, 0x58, 0xf1,
+	0xba, 0xac, 0xb4, 0x8f, 0x42, 0x14, 0xf5, 0xe2, 0x43, 0x47, 0x6e, 0xf0, 0x79, 0x91, 0xea, 0x34,
+	0x51, 0x00, 0xda, 0xef, 0x86, 0x28, 0xba, 0x88, 0x47, 0x66, 0x10, 0x03, 0x68, 0xf2, 0x84, 0xbd,
+	0x55, 0x95, 0x2a, 0x9e, 0xd8, 0xcb, 0x7e, 0x2f, 0x44, 0x91, 0xf7, 0x30, 0xa1, 0xff, 0x63, 0xd1,
+	0x17, 0x63, 0xb5, 0xff, 0x8c, 0xf1, 0xea, 0x58, 0x93, 0x4b, 0x3c, 0x10, 0x20, 0x72, 0xee, 0xf7,
+	0x43, 0x14, 0xf5, 0x63, 0xd7, 0x90, 0x3b, 0xec, 0x65, 0xbc, 0xac, 0x45, 0x92, 0x35, 0x90, 0x2f,
+	0xfd, 0x81, 0xd5, 0xb0, 0x1d, 0xcd, 0xcd, 0xc4, 0x80, 0x71, 0x51, 0x1c, 0xe4, 0xa1, 0x95, 0x47,
+	0x5c, 0x14, 0x4e, 0x5c, 0xe0, 0xab, 0x23, 0x75, 0xa2, 0xd7, 0xb2, 0x69, 0x11, 0xcf, 0x2c, 0xe2,
+	0xed, 0x29, 0x44, 0x47, 0x47, 0xda, 0x07, 0xbe, 0x9a, 0x4d, 0x97, 0xd2, 0xf3, 0xf7, 0x2e, 0x40,
+	0xdb, 0x5d, 0x80, 0x7e, 0x77, 0x01, 0xfa, 0xdc, 0x07, 0x9d, 0xed, 0x3e, 0xe8, 0xfc, 0xec, 0x83,
+	0xce, 0xdb, 0xb4, 0xac, 0x75, 0xb5, 0xce, 0x68, 0x0e, 0x1f, 0xac, 0x3d, 0x0b, 0xaa, 0x3c, 0xd6,
+	0xd3, 0x54, 0x4a, 0x26, 0x97, 0xa5, 0x8b, 0x3f, 0x1b, 0xda, 0xfc, 0x1f, 0xff, 0x06, 0x00, 0x3d,
+	0x53, 0xf9, 0xa8, 0xe2, 0x01, 0x00, 0x00,
+}
+
+func (m *ProofBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DataRootTupleProof != nil {
+		{
+			size, err := m.DataRootTupleProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBundle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EndBlock != 0 {
+		i = encodeVarintBundle(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BeginBlock != 0 {
+		i = encodeVarintBundle(dAtA, i, uint64(m.BeginBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintBundle(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ShareProof != nil {
+		{
+			size, err := m.ShareProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBundle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintBundle(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintBundle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBundle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBundle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProofBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBundle(uint64(m.Height))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovBundle(uint64(l))
+	}
+	if m.ShareProof != nil {
+		l = m.ShareProof.Size()
+		n += 1 + l + sovBundle(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovBundle(uint64(m.Nonce))
+	}
+	if m.BeginBlock != 0 {
+		n += 1 + sovBundle(uint64(m.BeginBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovBundle(uint64(m.EndBlock))
+	}
+	if m.DataRootTupleProof != nil {
+		l = m.DataRootTupleProof.Size()
+		n += 1 + l + sovBundle(uint64(l))
+	}
+	return n
+}
+
+func sovBundle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBundle(x uint64) (n int) {
+	return sovBundle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProofBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBundle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShareProof == nil {
+				m.ShareProof = &ShareProof{}
+			}
+			if err := m.ShareProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			m.BeginBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeginBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRootTupleProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBundle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataRootTupleProof == nil {
+				m.DataRootTupleProof = &Proof{}
+			}
+			if err := m.DataRootTupleProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBundle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBundle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBundle(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBundle
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBundle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBundle
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBundle
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBundle
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBundle        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBundle          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBundle = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,135 @@
+package proof_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/rpc/core"
+)
+
+func TestProofBundleVerify(t *testing.T) {
+	bundle, dataCommitmentRoot := newProofBundle(t)
+	require.NoError(t, bundle.Verify(dataCommitmentRoot))
+
+	testCases := []struct {
+		name   string
+		modify func(b *proof.ProofBundle) []byte
+	}{
+		{
+			name: "wrong data commitment root",
+			modify: func(_ *proof.ProofBundle) []byte {
+				return tmrand.Bytes(32)
+			},
+		},
+		{
+			name: "wrong height",
+			modify: func(b *proof.ProofBundle) []byte {
+				b.Height++
+				return dataCommitmentRoot
+			},
+		},
+		{
+			name: "height outside of data commitment range",
+			modify: func(b *proof.ProofBundle) []byte {
+				b.EndBlock = uint64(b.Height)
+				return dataCommitmentRoot
+			},
+		},
+		{
+			name: "wrong data root",
+			modify: func(b *proof.ProofBundle) []byte {
+				b.DataRoot = tmrand.Bytes(32)
+				return dataCommitmentRoot
+			},
+		},
+		{
+			name: "modified share",
+			modify: func(b *proof.ProofBundle) []byte {
+				b.ShareProof.Data[0] = tmrand.Bytes(len(b.ShareProof.Data[0]))
+				return dataCommitmentRoot
+			},
+		},
+		{
+			name: "missing share proof",
+			modify: func(b *proof.ProofBundle) []byte {
+				b.ShareProof = nil
+				return dataCommitmentRoot
+			},
+		},
+		{
+			name: "missing data root tuple proof",
+			modify: func(b *proof.ProofBundle) []byte {
+				b.DataRootTupleProof = nil
+				return dataCommitmentRoot
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bundle, _ := newProofBundle(t)
+			root := tc.modify(&bundle)
+			require.Error(t, bundle.Verify(root))
+		})
+	}
+}
+
+func TestEncodeDataRootTuple(t *testing.T) {
+	dataRoot := *(*[32]byte)(tmrand.Bytes(32))
+	for _, height := range []uint64{0, 1, 10, 256, 1 << 40} {
+		want, err := core.EncodeDataRootTuple(height, dataRoot)
+		require.NoError(t, err)
+		require.Equal(t, want, proof.EncodeDataRootTuple(height, dataRoot))
+	}
+}
+
+// newProofBundle returns a valid proof bundle for a transaction share at
+// height 3 of a data commitment over the blocks [1, 5) along with the data
+// commitment root.
+func newProofBundle(t *testing.T) (proof.ProofBundle, []byte) {
+	const (
+		height     = 3
+		beginBlock = 1
+		endBlock   = 5
+	)
+
+	txs := testfactory.GenerateRandomTxs(10, 500)
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	dataRoot := dah.Hash()
+
+	shareProof, err := proof.NewShareInclusionProof(dataSquare, share.TxNamespace, share.NewRange(0, 2))
+	require.NoError(t, err)
+
+	tuples := make([][]byte, 0, endBlock-beginBlock)
+	for h := uint64(beginBlock); h < endBlock; h++ {
+		root := *(*[32]byte)(tmrand.Bytes(32))
+		if h == height {
+			root = *(*[32]byte)(dataRoot)
+		}
+		tuples = append(tuples, proof.EncodeDataRootTuple(h, root))
+	}
+	dataCommitmentRoot, tupleProofs := merkle.ProofsFromByteSlices(tuples)
+
+	return proof.ProofBundle{
+		Height:             height,
+		DataRoot:           dataRoot,
+		ShareProof:         &shareProof,
+		Nonce:              2,
+		BeginBlock:         beginBlock,
+		EndBlock:           endBlock,
+		DataRootTupleProof: proof.ProofFromMerkle(*tupleProofs[height-beginBlock]),
+	}, dataCommitmentRoot
+}
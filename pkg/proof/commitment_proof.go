@@ -0,0 +1,207 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/inclusion"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+	"github.com/celestiaorg/go-square/v2"
+	squareinclusion "github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// NewCommitmentProof takes an ODS and returns a proof of inclusion of the
+// share commitment of the blob occupying the share range to the data root. The
+// share range must contain all the shares of a single blob.
+func NewCommitmentProof(dataSquare square.Square, blobRange share.Range, subtreeRootThreshold int) (CommitmentProof, error) {
+	namespace, err := ParseNamespace(dataSquare, blobRange.Start, blobRange.End)
+	if err != nil {
+		return CommitmentProof{}, err
+	}
+
+	// extend the square using the subtree root cacher to be able to get the
+	// subtree roots used to compute the share commitment.
+	squareSize := square.Size(len(dataSquare))
+	cacher := inclusion.NewSubtreeCacher(uint64(squareSize))
+	eds, err := rsmt2d.ComputeExtendedDataSquare(share.ToBytes(dataSquare), appconsts.DefaultCodec(), cacher.Constructor)
+	if err != nil {
+		return CommitmentProof{}, err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return CommitmentProof{}, err
+	}
+
+	blobShareLen := blobRange.End - blobRange.Start
+	if start := squareinclusion.NextShareIndex(blobRange.Start, blobShareLen, subtreeRootThreshold); start != blobRange.Start {
+		return CommitmentProof{}, fmt.Errorf("blob must start at share %d instead of %d", start, blobRange.Start)
+	}
+	subtreeRoots, subtreeRootRows, err := inclusion.GetSubtreeRoots(cacher, dah, blobRange.Start, blobShareLen, subtreeRootThreshold)
+	if err != nil {
+		return CommitmentProof{}, err
+	}
+
+	startRow := blobRange.Start / squareSize
+	endRow := (blobRange.End - 1) / squareSize
+	rowSubtreeRoots := make([]*SubtreeRoots, endRow-startRow+1)
+	for i := range rowSubtreeRoots {
+		rowSubtreeRoots[i] = &SubtreeRoots{}
+	}
+	for i, row := range subtreeRootRows {
+		rowSubtreeRoots[row-startRow].SubtreeRoots = append(rowSubtreeRoots[row-startRow].SubtreeRoots, subtreeRoots[i])
+	}
+
+	edsColRoots, err := eds.ColRoots()
+	if err != nil {
+		return CommitmentProof{}, err
+	}
+	// create the binary merkle inclusion proof for all the square rows to the data root
+	_, allProofs := merkle.ProofsFromByteSlices(append(dah.RowRoots, edsColRoots...))
+
+	proof := CommitmentProof{
+		SubtreeRoots:      rowSubtreeRoots,
+		SubtreeRootProofs: make([]*NMTProof, 0, endRow-startRow+1),
+		NamespaceId:       namespace.ID(),
+		NamespaceVersion:  uint32(namespace.Version()),
+		RowProof: &RowProof{
+			RowRoots: dah.RowRoots[startRow : endRow+1],
+			Proofs:   make([]*Proof, 0, endRow-startRow+1),
+			StartRow: uint32(startRow),
+			EndRow:   uint32(endRow),
+		},
+		ShareCommitment: merkle.HashFromByteSlices(subtreeRoots),
+	}
+	for row := startRow; row <= endRow; row++ {
+		tree, err := wrapper.NewEDSAxisTree(eds, rsmt2d.Row, uint(row))
+		if err != nil {
+			return CommitmentProof{}, err
+		}
+
+		startLeaf, endLeaf := 0, squareSize
+		if row == startRow {
+			startLeaf = blobRange.Start % squareSize
+		}
+		if row == endRow {
+			endLeaf = (blobRange.End-1)%squareSize + 1
+		}
+		nmtProof, err := tree.ProveRange(startLeaf, endLeaf)
+		if err != nil {
+			return CommitmentProof{}, err
+		}
+		proof.SubtreeRootProofs = append(proof.SubtreeRootProofs, &NMTProof{
+			Start: int32(nmtProof.Start()),
+			End:   int32(nmtProof.End()),
+			Nodes: nmtProof.Nodes(),
+		})
+		proof.RowProof.Proofs = append(proof.RowProof.Proofs, ProofFromMerkle(*allProofs[row]))
+	}
+	return proof, nil
+}
+
+// Validate runs basic validations on the proof then verifies that the blob
+// with the share commitment of the proof is included in the data square with
+// the given data root. The share commitment is recomputed from the subtree
+// roots proven to be in the square so the caller only needs to compare it to
+// the share commitment of the MsgPayForBlobs. subtreeRootThreshold must be the
+// one of the app version of the block. It returns nil if the proof is valid.
+func (p CommitmentProof) Validate(root []byte, subtreeRootThreshold int) error {
+	if len(p.ShareCommitment) == 0 {
+		return errors.New("empty share commitment")
+	}
+	if p.NamespaceVersion > math.MaxUint8 {
+		return fmt.Errorf("invalid namespace version %d", p.NamespaceVersion)
+	}
+	if p.RowProof == nil {
+		return errors.New("nil row proof")
+	}
+	if p.RowProof.EndRow < p.RowProof.StartRow {
+		return errors.New("end row must not be lower than the start row")
+	}
+	for i, proof := range p.RowProof.Proofs {
+		if proof == nil {
+			return fmt.Errorf("nil proof for row %d", int(p.RowProof.StartRow)+i)
+		}
+	}
+	if err := p.RowProof.Validate(root); err != nil {
+		return err
+	}
+	numRows := len(p.RowProof.RowRoots)
+	if len(p.SubtreeRoots) != numRows || len(p.SubtreeRootProofs) != numRows {
+		return fmt.Errorf("the number of rows %d, subtree roots %d and subtree root proofs %d must be equal", numRows, len(p.SubtreeRoots), len(p.SubtreeRootProofs))
+	}
+
+	// the row roots and the column roots are the leaves of the data root so
+	// the original data square size is a quarter of the number of leaves.
+	total := p.RowProof.Proofs[0].Total
+	if total <= 0 || total%4 != 0 || total/4 > math.MaxInt32 {
+		return fmt.Errorf("invalid number of data root leaves %d", total)
+	}
+	squareSize := int(total / 4)
+	if int(p.RowProof.EndRow) >= squareSize {
+		return fmt.Errorf("row %d is not in the original data square of size %d", p.RowProof.EndRow, squareSize)
+	}
+
+	// the blob shares must be contiguous, starting in the first row and ending
+	// in the last one.
+	blobShareLen := 0
+	for i, proof := range p.SubtreeRootProofs {
+		row := int(p.RowProof.StartRow) + i
+		if p.RowProof.Proofs[i].Index != int64(row) {
+			return fmt.Errorf("proof of row %d is for leaf %d", row, p.RowProof.Proofs[i].Index)
+		}
+		if proof == nil || p.SubtreeRoots[i] == nil {
+			return fmt.Errorf("nil subtree roots or proof for row %d", row)
+		}
+		if proof.Start < 0 || proof.End <= proof.Start || int(proof.End) > squareSize {
+			return fmt.Errorf("invalid subtree root proof range [%d, %d) for row %d", proof.Start, proof.End, row)
+		}
+		if i > 0 && proof.Start != 0 {
+			return fmt.Errorf("the blob must start at the beginning of row %d", row)
+		}
+		if i < numRows-1 && int(proof.End) != squareSize {
+			return fmt.Errorf("the blob must span until the end of row %d", row)
+		}
+		blobShareLen += int(proof.End - proof.Start)
+	}
+
+	namespace := append([]byte{uint8(p.NamespaceVersion)}, p.NamespaceId...)
+	subtreeWidth := squareinclusion.SubTreeWidth(blobShareLen, subtreeRootThreshold)
+	nth := nmt.NewNmtHasher(appconsts.NewBaseHashFunc(), share.NamespaceSize, true)
+	var subtreeRoots [][]byte
+	for i, proof := range p.SubtreeRootProofs {
+		row := int(p.RowProof.StartRow) + i
+		roots := p.SubtreeRoots[i].SubtreeRoots
+		// the subtree roots only commit to the blob shares so their namespace
+		// range must only contain the blob namespace.
+		for _, subtreeRoot := range roots {
+			if len(subtreeRoot) < 2*share.NamespaceSize ||
+				!bytes.Equal(nmt.MinNamespace(subtreeRoot, share.NamespaceSize), namespace) ||
+				!bytes.Equal(nmt.MaxNamespace(subtreeRoot, share.NamespaceSize), namespace) {
+				return fmt.Errorf("subtree root of row %d is not in the blob namespace", row)
+			}
+		}
+
+		nmtProof := nmt.NewInclusionProof(int(proof.Start), int(proof.End), proof.Nodes, true)
+		valid, err := nmtProof.VerifySubtreeRootInclusion(nth, roots, subtreeWidth, p.RowProof.RowRoots[i])
+		if err != nil {
+			return fmt.Errorf("subtree roots of row %d: %w", row, err)
+		}
+		if !valid {
+			return fmt.Errorf("subtree roots proof of row %d failed to verify", row)
+		}
+		subtreeRoots = append(subtreeRoots, roots...)
+	}
+
+	if !bytes.Equal(merkle.HashFromByteSlices(subtreeRoots), p.ShareCommitment) {
+		return errors.New("the subtree roots don't match the share commitment")
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package proof_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestCommitmentProof(t *testing.T) {
+	blobs, dataSquare, dataRoot := newCommitmentTestSquare(t)
+	threshold := appconsts.SubtreeRootThreshold(appconsts.LatestVersion)
+
+	for i, blob := range blobs {
+		commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, threshold)
+		require.NoError(t, err)
+
+		blobRange := blobShareRange(t, dataSquare, blob)
+		p, err := proof.NewCommitmentProof(dataSquare, blobRange, threshold)
+		require.NoError(t, err, "blob %d", i)
+		assert.Equal(t, commitment, p.ShareCommitment, "blob %d", i)
+		assert.NoError(t, p.Validate(dataRoot, threshold), "blob %d", i)
+	}
+}
+
+func TestCommitmentProofValidateInvalid(t *testing.T) {
+	blobs, dataSquare, dataRoot := newCommitmentTestSquare(t)
+	threshold := appconsts.SubtreeRootThreshold(appconsts.LatestVersion)
+	// the largest blob spans several rows
+	blobRange := blobShareRange(t, dataSquare, blobs[1])
+
+	tests := []struct {
+		name   string
+		modify func(p *proof.CommitmentProof)
+	}{
+		{
+			name:   "modified share commitment",
+			modify: func(p *proof.CommitmentProof) { p.ShareCommitment = tmrand.Bytes(len(p.ShareCommitment)) },
+		},
+		{
+			name:   "no share commitment",
+			modify: func(p *proof.CommitmentProof) { p.ShareCommitment = nil },
+		},
+		{
+			name: "modified subtree root",
+			modify: func(p *proof.CommitmentProof) {
+				root := p.SubtreeRoots[0].SubtreeRoots[0]
+				copy(root[len(root)-8:], tmrand.Bytes(8))
+			},
+		},
+		{
+			name:   "missing subtree root",
+			modify: func(p *proof.CommitmentProof) { p.SubtreeRoots[0].SubtreeRoots = p.SubtreeRoots[0].SubtreeRoots[1:] },
+		},
+		{
+			name: "missing row",
+			modify: func(p *proof.CommitmentProof) {
+				p.SubtreeRoots, p.SubtreeRootProofs = p.SubtreeRoots[1:], p.SubtreeRootProofs[1:]
+			},
+		},
+		{
+			name:   "shifted subtree root proof",
+			modify: func(p *proof.CommitmentProof) { p.SubtreeRootProofs[0].Start++; p.SubtreeRootProofs[0].End++ },
+		},
+		{
+			name:   "other namespace",
+			modify: func(p *proof.CommitmentProof) { p.NamespaceId = blobs[0].Namespace().ID() },
+		},
+		{
+			name:   "nil row proof",
+			modify: func(p *proof.CommitmentProof) { p.RowProof = nil },
+		},
+		{
+			name:   "nil row root proof",
+			modify: func(p *proof.CommitmentProof) { p.RowProof.Proofs[0] = nil },
+		},
+		{
+			name:   "modified row root",
+			modify: func(p *proof.CommitmentProof) { p.RowProof.RowRoots[0] = tmrand.Bytes(len(p.RowProof.RowRoots[0])) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := proof.NewCommitmentProof(dataSquare, blobRange, threshold)
+			require.NoError(t, err)
+			require.Greater(t, len(p.SubtreeRoots), 1)
+			require.NoError(t, p.Validate(dataRoot, threshold))
+			tt.modify(&p)
+			assert.Error(t, p.Validate(dataRoot, threshold))
+		})
+	}
+
+	p, err := proof.NewCommitmentProof(dataSquare, blobRange, threshold)
+	require.NoError(t, err)
+	assert.Error(t, p.Validate(tmrand.Bytes(len(dataRoot)), threshold))
+}
+
+func TestNewCommitmentProofInvalidRange(t *testing.T) {
+	blobs, dataSquare, _ := newCommitmentTestSquare(t)
+	threshold := appconsts.SubtreeRootThreshold(appconsts.LatestVersion)
+	blobRange := blobShareRange(t, dataSquare, blobs[1])
+
+	// the range spans several namespaces
+	_, err := proof.NewCommitmentProof(dataSquare, share.NewRange(blobRange.Start-1, blobRange.End), threshold)
+	assert.Error(t, err)
+	// the range doesn't start at the blob start
+	_, err = proof.NewCommitmentProof(dataSquare, share.NewRange(blobRange.Start+1, blobRange.End), threshold)
+	assert.Error(t, err)
+}
+
+// newCommitmentTestSquare returns the blobs of a square containing random
+// transactions and blobs of different sizes in the namespaces 1, 2 and 3, along
+// with the square and its data root.
+func newCommitmentTestSquare(t *testing.T) ([]*share.Blob, square.Square, []byte) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	namespaces := []share.Namespace{multiShareTestNamespace(1), multiShareTestNamespace(2), multiShareTestNamespace(3)}
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, namespaces, []int{500, 100000, 5000})
+	txs := append(testfactory.GenerateRandomTxs(50, 500), blobTxs...).ToSliceOfBytes()
+
+	var blobs []*share.Blob
+	for _, rawTx := range blobTxs {
+		blobTx, isBlobTx, err := tx.UnmarshalBlobTx(rawTx)
+		require.NoError(t, err)
+		require.True(t, isBlobTx)
+		blobs = append(blobs, blobTx.Blobs...)
+	}
+
+	dataSquare, err := square.Construct(txs, appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	return blobs, dataSquare, dah.Hash()
+}
+
+// blobShareRange returns the range of the shares of the blob in the square,
+// excluding the namespace padding shares following it.
+func blobShareRange(t *testing.T, dataSquare square.Square, blob *share.Blob) share.Range {
+	start := namespaceRange(t, dataSquare, blob.Namespace()).Start
+	return share.NewRange(start, start+share.SparseSharesNeeded(uint32(len(blob.Data()))))
+}
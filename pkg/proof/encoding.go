@@ -0,0 +1,588 @@
+package proof
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// The JSON encoding of the proofs is the canonical form used by light clients
+// that can't decode protobuf. The fields are in snake case and the byte
+// fields, i.e. the hashes, shares and namespaces, are encoded as lowercase hex
+// strings without a 0x prefix. It is provided by dedicated methods instead of
+// json.Marshaler because the protobuf JSON encoding, which encodes the byte
+// fields in base64, relies on the default behavior.
+
+// hexBytes is a byte slice that is encoded as a hex string in JSON.
+type hexBytes []byte
+
+func (b hexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToString(b))
+}
+
+func (b *hexBytes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*b = nil
+		return nil
+	}
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("invalid hex string %q: %w", s, err)
+	}
+	*b = bz
+	return nil
+}
+
+func toHexBytes(bzs [][]byte) []hexBytes {
+	if bzs == nil {
+		return nil
+	}
+	hbzs := make([]hexBytes, len(bzs))
+	for i, bz := range bzs {
+		hbzs[i] = bz
+	}
+	return hbzs
+}
+
+func fromHexBytes(hbzs []hexBytes) [][]byte {
+	if hbzs == nil {
+		return nil
+	}
+	bzs := make([][]byte, len(hbzs))
+	for i, hbz := range hbzs {
+		bzs[i] = hbz
+	}
+	return bzs
+}
+
+type shareProofJSON struct {
+	Data             []hexBytes      `json:"data"`
+	ShareProofs      []*nmtProofJSON `json:"share_proofs"`
+	NamespaceID      hexBytes        `json:"namespace_id"`
+	NamespaceVersion uint32          `json:"namespace_version"`
+	RowProof         *rowProofJSON   `json:"row_proof"`
+}
+
+type rowProofJSON struct {
+	RowRoots []hexBytes         `json:"row_roots"`
+	Proofs   []*merkleProofJSON `json:"proofs"`
+	Root     hexBytes           `json:"root"`
+	StartRow uint32             `json:"start_row"`
+	EndRow   uint32             `json:"end_row"`
+}
+
+type nmtProofJSON struct {
+	Start    int32      `json:"start"`
+	End      int32      `json:"end"`
+	Nodes    []hexBytes `json:"nodes"`
+	LeafHash hexBytes   `json:"leaf_hash"`
+}
+
+type merkleProofJSON struct {
+	Total    int64      `json:"total"`
+	Index    int64      `json:"index"`
+	LeafHash hexBytes   `json:"leaf_hash"`
+	Aunts    []hexBytes `json:"aunts"`
+}
+
+// MarshalCanonicalJSON encodes the share proof in the canonical JSON format.
+func (sp ShareProof) MarshalCanonicalJSON() ([]byte, error) {
+	return json.Marshal(newShareProofJSON(sp))
+}
+
+// UnmarshalCanonicalJSON decodes a share proof from the canonical JSON format.
+func (sp *ShareProof) UnmarshalCanonicalJSON(data []byte) error {
+	var v shareProofJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*sp = v.toProto()
+	return nil
+}
+
+// MarshalCanonicalJSON encodes the row proof in the canonical JSON format.
+func (rp RowProof) MarshalCanonicalJSON() ([]byte, error) {
+	return json.Marshal(newRowProofJSON(rp))
+}
+
+// UnmarshalCanonicalJSON decodes a row proof from the canonical JSON format.
+func (rp *RowProof) UnmarshalCanonicalJSON(data []byte) error {
+	var v rowProofJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*rp = v.toProto()
+	return nil
+}
+
+// MarshalCanonicalJSON encodes the NMT proof in the canonical JSON format.
+func (p NMTProof) MarshalCanonicalJSON() ([]byte, error) {
+	return json.Marshal(newNMTProofJSON(p))
+}
+
+// UnmarshalCanonicalJSON decodes an NMT proof from the canonical JSON format.
+func (p *NMTProof) UnmarshalCanonicalJSON(data []byte) error {
+	var v nmtProofJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*p = v.toProto()
+	return nil
+}
+
+func newShareProofJSON(sp ShareProof) shareProofJSON {
+	v := shareProofJSON{
+		Data:             toHexBytes(sp.Data),
+		NamespaceID:      sp.NamespaceId,
+		NamespaceVersion: sp.NamespaceVersion,
+	}
+	if sp.ShareProofs != nil {
+		v.ShareProofs = make([]*nmtProofJSON, len(sp.ShareProofs))
+		for i, p := range sp.ShareProofs {
+			if p != nil {
+				nmtProof := newNMTProofJSON(*p)
+				v.ShareProofs[i] = &nmtProof
+			}
+		}
+	}
+	if sp.RowProof != nil {
+		rowProof := newRowProofJSON(*sp.RowProof)
+		v.RowProof = &rowProof
+	}
+	return v
+}
+
+func (v shareProofJSON) toProto() ShareProof {
+	sp := ShareProof{
+		Data:             fromHexBytes(v.Data),
+		NamespaceId:      v.NamespaceID,
+		NamespaceVersion: v.NamespaceVersion,
+	}
+	if v.ShareProofs != nil {
+		sp.ShareProofs = make([]*NMTProof, len(v.ShareProofs))
+		for i, p := range v.ShareProofs {
+			if p != nil {
+				nmtProof := p.toProto()
+				sp.ShareProofs[i] = &nmtProof
+			}
+		}
+	}
+	if v.RowProof != nil {
+		rowProof := v.RowProof.toProto()
+		sp.RowProof = &rowProof
+	}
+	return sp
+}
+
+func newRowProofJSON(rp RowProof) rowProofJSON {
+	v := rowProofJSON{
+		RowRoots: toHexBytes(rp.RowRoots),
+		Root:     rp.Root,
+		StartRow: rp.StartRow,
+		EndRow:   rp.EndRow,
+	}
+	if rp.Proofs != nil {
+		v.Proofs = make([]*merkleProofJSON, len(rp.Proofs))
+		for i, p := range rp.Proofs {
+			if p != nil {
+				v.Proofs[i] = &merkleProofJSON{
+					Total:    p.Total,
+					Index:    p.Index,
+					LeafHash: p.LeafHash,
+					Aunts:    toHexBytes(p.Aunts),
+				}
+			}
+		}
+	}
+	return v
+}
+
+func (v rowProofJSON) toProto() RowProof {
+	rp := RowProof{
+		RowRoots: fromHexBytes(v.RowRoots),
+		Root:     v.Root,
+		StartRow: v.StartRow,
+		EndRow:   v.EndRow,
+	}
+	if v.Proofs != nil {
+		rp.Proofs = make([]*Proof, len(v.Proofs))
+		for i, p := range v.Proofs {
+			if p != nil {
+				rp.Proofs[i] = &Proof{
+					Total:    p.Total,
+					Index:    p.Index,
+					LeafHash: p.LeafHash,
+					Aunts:    fromHexBytes(p.Aunts),
+				}
+			}
+		}
+	}
+	return rp
+}
+
+func newNMTProofJSON(p NMTProof) nmtProofJSON {
+	return nmtProofJSON{
+		Start:    p.Start,
+		End:      p.End,
+		Nodes:    toHexBytes(p.Nodes),
+		LeafHash: p.LeafHash,
+	}
+}
+
+func (v nmtProofJSON) toProto() NMTProof {
+	return NMTProof{
+		Start:    v.Start,
+		End:      v.End,
+		Nodes:    fromHexBytes(v.Nodes),
+		LeafHash: v.LeafHash,
+	}
+}
+
+// The compact binary encoding of the proofs is meant for constrained
+// verifiers, e.g. zk circuits, that need a simpler format than protobuf. The
+// encoding starts with a tag identifying the proof type. Then, the fields are
+// written in order: integers as unsigned varints, byte slices prefixed by
+// their length and lists prefixed by their number of elements.
+
+// CompactTag identifies the type of a proof in the compact binary encoding.
+type CompactTag byte
+
+const (
+	CompactTagShareProof CompactTag = 0x01
+	CompactTagRowProof   CompactTag = 0x02
+	CompactTagNMTProof   CompactTag = 0x03
+)
+
+// MarshalCompact encodes the share proof in the compact binary format.
+func (sp ShareProof) MarshalCompact() ([]byte, error) {
+	w := compactWriter{buf: []byte{byte(CompactTagShareProof)}}
+	if err := w.shareProof(sp); err != nil {
+		return nil, err
+	}
+	return w.buf, nil
+}
+
+// UnmarshalCompact decodes a share proof from the compact binary format.
+func (sp *ShareProof) UnmarshalCompact(data []byte) error {
+	r, err := newCompactReader(data, CompactTagShareProof)
+	if err != nil {
+		return err
+	}
+	v, err := r.shareProof()
+	if err != nil {
+		return err
+	}
+	if err := r.done(); err != nil {
+		return err
+	}
+	*sp = v
+	return nil
+}
+
+// MarshalCompact encodes the row proof in the compact binary format.
+func (rp RowProof) MarshalCompact() ([]byte, error) {
+	w := compactWriter{buf: []byte{byte(CompactTagRowProof)}}
+	if err := w.rowProof(rp); err != nil {
+		return nil, err
+	}
+	return w.buf, nil
+}
+
+// UnmarshalCompact decodes a row proof from the compact binary format.
+func (rp *RowProof) UnmarshalCompact(data []byte) error {
+	r, err := newCompactReader(data, CompactTagRowProof)
+	if err != nil {
+		return err
+	}
+	v, err := r.rowProof()
+	if err != nil {
+		return err
+	}
+	if err := r.done(); err != nil {
+		return err
+	}
+	*rp = v
+	return nil
+}
+
+// MarshalCompact encodes the NMT proof in the compact binary format.
+func (p NMTProof) MarshalCompact() ([]byte, error) {
+	w := compactWriter{buf: []byte{byte(CompactTagNMTProof)}}
+	if err := w.nmtProof(p); err != nil {
+		return nil, err
+	}
+	return w.buf, nil
+}
+
+// UnmarshalCompact decodes an NMT proof from the compact binary format.
+func (p *NMTProof) UnmarshalCompact(data []byte) error {
+	r, err := newCompactReader(data, CompactTagNMTProof)
+	if err != nil {
+		return err
+	}
+	v, err := r.nmtProof()
+	if err != nil {
+		return err
+	}
+	if err := r.done(); err != nil {
+		return err
+	}
+	*p = v
+	return nil
+}
+
+type compactWriter struct {
+	buf []byte
+}
+
+func (w *compactWriter) uint(v uint64) {
+	w.buf = binary.AppendUvarint(w.buf, v)
+}
+
+func (w *compactWriter) int(v int64) error {
+	if v < 0 {
+		return fmt.Errorf("negative value %d can't be encoded", v)
+	}
+	w.uint(uint64(v))
+	return nil
+}
+
+func (w *compactWriter) bytes(bz []byte) {
+	w.uint(uint64(len(bz)))
+	w.buf = append(w.buf, bz...)
+}
+
+func (w *compactWriter) bytesList(bzs [][]byte) {
+	w.uint(uint64(len(bzs)))
+	for _, bz := range bzs {
+		w.bytes(bz)
+	}
+}
+
+func (w *compactWriter) shareProof(sp ShareProof) error {
+	w.bytesList(sp.Data)
+	w.uint(uint64(len(sp.ShareProofs)))
+	for _, p := range sp.ShareProofs {
+		if p == nil {
+			return errors.New("nil share proof")
+		}
+		if err := w.nmtProof(*p); err != nil {
+			return err
+		}
+	}
+	w.bytes(sp.NamespaceId)
+	w.uint(uint64(sp.NamespaceVersion))
+	if sp.RowProof == nil {
+		return errors.New("nil row proof")
+	}
+	return w.rowProof(*sp.RowProof)
+}
+
+func (w *compactWriter) rowProof(rp RowProof) error {
+	w.bytesList(rp.RowRoots)
+	w.uint(uint64(len(rp.Proofs)))
+	for _, p := range rp.Proofs {
+		if p == nil {
+			return errors.New("nil row root proof")
+		}
+		if err := w.int(p.Total); err != nil {
+			return err
+		}
+		if err := w.int(p.Index); err != nil {
+			return err
+		}
+		w.bytes(p.LeafHash)
+		w.bytesList(p.Aunts)
+	}
+	w.bytes(rp.Root)
+	w.uint(uint64(rp.StartRow))
+	w.uint(uint64(rp.EndRow))
+	return nil
+}
+
+func (w *compactWriter) nmtProof(p NMTProof) error {
+	if err := w.int(int64(p.Start)); err != nil {
+		return err
+	}
+	if err := w.int(int64(p.End)); err != nil {
+		return err
+	}
+	w.bytesList(p.Nodes)
+	w.bytes(p.LeafHash)
+	return nil
+}
+
+type compactReader struct {
+	buf []byte
+}
+
+func newCompactReader(data []byte, tag CompactTag) (*compactReader, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty compact proof")
+	}
+	if CompactTag(data[0]) != tag {
+		return nil, fmt.Errorf("unexpected compact proof tag %#x, expected %#x", data[0], byte(tag))
+	}
+	return &compactReader{buf: data[1:]}, nil
+}
+
+func (r *compactReader) done() error {
+	if len(r.buf) != 0 {
+		return fmt.Errorf("%d trailing bytes after the compact proof", len(r.buf))
+	}
+	return nil
+}
+
+func (r *compactReader) uint(max uint64) (uint64, error) {
+	v, n := binary.Uvarint(r.buf)
+	if n <= 0 {
+		return 0, errors.New("invalid varint")
+	}
+	if v > max {
+		return 0, fmt.Errorf("value %d exceeds the maximum %d", v, max)
+	}
+	r.buf = r.buf[n:]
+	return v, nil
+}
+
+// length reads a length or a number of elements, each of them taking at least
+// one byte, so it can't exceed the number of remaining bytes.
+func (r *compactReader) length() (int, error) {
+	v, err := r.uint(uint64(len(r.buf)))
+	return int(v), err
+}
+
+func (r *compactReader) bytes() ([]byte, error) {
+	n, err := r.length()
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, nil
+	}
+	bz := make([]byte, n)
+	copy(bz, r.buf[:n])
+	r.buf = r.buf[n:]
+	return bz, nil
+}
+
+func (r *compactReader) bytesList() ([][]byte, error) {
+	n, err := r.length()
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, nil
+	}
+	bzs := make([][]byte, n)
+	for i := range bzs {
+		if bzs[i], err = r.bytes(); err != nil {
+			return nil, err
+		}
+	}
+	return bzs, nil
+}
+
+func (r *compactReader) shareProof() (sp ShareProof, err error) {
+	if sp.Data, err = r.bytesList(); err != nil {
+		return ShareProof{}, err
+	}
+	n, err := r.length()
+	if err != nil {
+		return ShareProof{}, err
+	}
+	if n > 0 {
+		sp.ShareProofs = make([]*NMTProof, n)
+	}
+	for i := range sp.ShareProofs {
+		p, err := r.nmtProof()
+		if err != nil {
+			return ShareProof{}, err
+		}
+		sp.ShareProofs[i] = &p
+	}
+	if sp.NamespaceId, err = r.bytes(); err != nil {
+		return ShareProof{}, err
+	}
+	version, err := r.uint(math.MaxUint32)
+	if err != nil {
+		return ShareProof{}, err
+	}
+	sp.NamespaceVersion = uint32(version)
+	rp, err := r.rowProof()
+	if err != nil {
+		return ShareProof{}, err
+	}
+	sp.RowProof = &rp
+	return sp, nil
+}
+
+func (r *compactReader) rowProof() (rp RowProof, err error) {
+	if rp.RowRoots, err = r.bytesList(); err != nil {
+		return RowProof{}, err
+	}
+	n, err := r.length()
+	if err != nil {
+		return RowProof{}, err
+	}
+	if n > 0 {
+		rp.Proofs = make([]*Proof, n)
+	}
+	for i := range rp.Proofs {
+		total, err := r.uint(math.MaxInt64)
+		if err != nil {
+			return RowProof{}, err
+		}
+		index, err := r.uint(math.MaxInt64)
+		if err != nil {
+			return RowProof{}, err
+		}
+		p := &Proof{Total: int64(total), Index: int64(index)}
+		if p.LeafHash, err = r.bytes(); err != nil {
+			return RowProof{}, err
+		}
+		if p.Aunts, err = r.bytesList(); err != nil {
+			return RowProof{}, err
+		}
+		rp.Proofs[i] = p
+	}
+	if rp.Root, err = r.bytes(); err != nil {
+		return RowProof{}, err
+	}
+	startRow, err := r.uint(math.MaxUint32)
+	if err != nil {
+		return RowProof{}, err
+	}
+	endRow, err := r.uint(math.MaxUint32)
+	if err != nil {
+		return RowProof{}, err
+	}
+	rp.StartRow, rp.EndRow = uint32(startRow), uint32(endRow)
+	return rp, nil
+}
+
+func (r *compactReader) nmtProof() (p NMTProof, err error) {
+	start, err := r.uint(math.MaxInt32)
+	if err != nil {
+		return NMTProof{}, err
+	}
+	end, err := r.uint(math.MaxInt32)
+	if err != nil {
+		return NMTProof{}, err
+	}
+	p.Start, p.End = int32(start), int32(end)
+	if p.Nodes, err = r.bytesList(); err != nil {
+		return NMTProof{}, err
+	}
+	if p.LeafHash, err = r.bytes(); err != nil {
+		return NMTProof{}, err
+	}
+	return p, nil
+}
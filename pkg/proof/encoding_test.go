@@ -0,0 +1,142 @@
+package proof_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShareProofEncoding(t *testing.T) {
+	sp, dataRoot := newEncodingTestProof(t)
+
+	t.Run("json", func(t *testing.T) {
+		bz, err := sp.MarshalCanonicalJSON()
+		require.NoError(t, err)
+
+		var decoded proof.ShareProof
+		require.NoError(t, decoded.UnmarshalCanonicalJSON(bz))
+		assert.Equal(t, sp, decoded)
+		assert.NoError(t, decoded.Validate(dataRoot))
+
+		// the byte fields are lowercase hex strings
+		var raw map[string]any
+		require.NoError(t, json.Unmarshal(bz, &raw))
+		assert.Equal(t, hex.EncodeToString(sp.NamespaceId), raw["namespace_id"])
+	})
+
+	t.Run("compact", func(t *testing.T) {
+		bz, err := sp.MarshalCompact()
+		require.NoError(t, err)
+		assert.Equal(t, byte(proof.CompactTagShareProof), bz[0])
+
+		var decoded proof.ShareProof
+		require.NoError(t, decoded.UnmarshalCompact(bz))
+		assert.Equal(t, sp, decoded)
+		assert.NoError(t, decoded.Validate(dataRoot))
+
+		// the compact encoding is smaller than the protobuf one
+		protoBz, err := sp.Marshal()
+		require.NoError(t, err)
+		assert.Less(t, len(bz), len(protoBz))
+	})
+}
+
+func TestRowProofEncoding(t *testing.T) {
+	sp, dataRoot := newEncodingTestProof(t)
+	rp := *sp.RowProof
+
+	bz, err := rp.MarshalCanonicalJSON()
+	require.NoError(t, err)
+	var fromJSON proof.RowProof
+	require.NoError(t, fromJSON.UnmarshalCanonicalJSON(bz))
+	assert.Equal(t, rp, fromJSON)
+	assert.NoError(t, fromJSON.Validate(dataRoot))
+
+	bz, err = rp.MarshalCompact()
+	require.NoError(t, err)
+	var fromCompact proof.RowProof
+	require.NoError(t, fromCompact.UnmarshalCompact(bz))
+	assert.Equal(t, rp, fromCompact)
+	assert.NoError(t, fromCompact.Validate(dataRoot))
+}
+
+func TestNMTProofEncoding(t *testing.T) {
+	sp, _ := newEncodingTestProof(t)
+	p := *sp.ShareProofs[0]
+	p.LeafHash = []byte{1, 2, 3}
+
+	bz, err := p.MarshalCanonicalJSON()
+	require.NoError(t, err)
+	var fromJSON proof.NMTProof
+	require.NoError(t, fromJSON.UnmarshalCanonicalJSON(bz))
+	assert.Equal(t, p, fromJSON)
+
+	bz, err = p.MarshalCompact()
+	require.NoError(t, err)
+	var fromCompact proof.NMTProof
+	require.NoError(t, fromCompact.UnmarshalCompact(bz))
+	assert.Equal(t, p, fromCompact)
+}
+
+func TestUnmarshalCompactInvalid(t *testing.T) {
+	sp, _ := newEncodingTestProof(t)
+	bz, err := sp.MarshalCompact()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{
+			name: "empty",
+			data: nil,
+		},
+		{
+			name: "wrong tag",
+			data: append([]byte{byte(proof.CompactTagRowProof)}, bz[1:]...),
+		},
+		{
+			name: "truncated",
+			data: bz[:len(bz)-1],
+		},
+		{
+			name: "trailing bytes",
+			data: append(bz, 0),
+		},
+		{
+			name: "length overflow",
+			data: []byte{byte(proof.CompactTagShareProof), 0xff, 0xff, 0xff, 0xff, 0x0f},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var decoded proof.ShareProof
+			assert.Error(t, decoded.UnmarshalCompact(tt.data))
+		})
+	}
+
+	// proofs containing nil elements can't be encoded
+	sp.ShareProofs[0] = nil
+	_, err = sp.MarshalCompact()
+	assert.Error(t, err)
+}
+
+func TestUnmarshalJSONInvalidHex(t *testing.T) {
+	var p proof.NMTProof
+	assert.Error(t, p.UnmarshalCanonicalJSON([]byte(`{"start":0,"end":1,"nodes":["zz"],"leaf_hash":""}`)))
+}
+
+// newEncodingTestProof returns a share proof of the namespace 1 shares of the
+// multi share test square along with the data root of the square.
+func newEncodingTestProof(t *testing.T) (proof.ShareProof, []byte) {
+	_, dataSquare, dataRoot := newMultiShareTestSquare(t)
+	namespace := multiShareTestNamespace(1)
+	sp, err := proof.NewShareInclusionProof(dataSquare, namespace, namespaceRange(t, dataSquare, namespace))
+	require.NoError(t, err)
+	require.NoError(t, sp.Validate(dataRoot))
+	return sp, dataRoot
+}
@@ -0,0 +1,225 @@
+package proof
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// NewMultiShareInclusionProof takes an ODS, extends it, then returns a proof
+// of inclusion of several share ranges to the data root. Each range must only
+// contain shares of a single namespace.
+func NewMultiShareInclusionProof(dataSquare square.Square, shareRanges []share.Range) (MultiShareProof, error) {
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	if err != nil {
+		return MultiShareProof{}, err
+	}
+	return NewMultiShareInclusionProofFromEDS(eds, shareRanges)
+}
+
+// NewMultiShareInclusionProofFromEDS takes an extended data square and returns
+// a proof of inclusion of several share ranges to the data root. Each range
+// must only contain shares of a single namespace. The row roots, and their
+// proofs to the data root, are only included once even if they are shared by
+// several ranges.
+func NewMultiShareInclusionProofFromEDS(eds *rsmt2d.ExtendedDataSquare, shareRanges []share.Range) (MultiShareProof, error) {
+	if len(shareRanges) == 0 {
+		return MultiShareProof{}, errors.New("no share ranges to prove")
+	}
+
+	odsShares, err := share.FromBytes(eds.FlattenedODS())
+	if err != nil {
+		return MultiShareProof{}, err
+	}
+	squareSize := square.Size(len(odsShares))
+
+	edsRowRoots, err := eds.RowRoots()
+	if err != nil {
+		return MultiShareProof{}, err
+	}
+	edsColRoots, err := eds.ColRoots()
+	if err != nil {
+		return MultiShareProof{}, err
+	}
+	_, allProofs := merkle.ProofsFromByteSlices(append(edsRowRoots, edsColRoots...))
+
+	// rows caches the extended rows containing shares to prove.
+	rows := make(map[int][]share.Share)
+	ranges := make([]*ShareRangeProof, len(shareRanges))
+	for i, shareRange := range shareRanges {
+		namespace, err := ParseNamespace(odsShares, shareRange.Start, shareRange.End)
+		if err != nil {
+			return MultiShareProof{}, fmt.Errorf("share range %d: %w", i, err)
+		}
+
+		startRow := shareRange.Start / squareSize
+		endRow := (shareRange.End - 1) / squareSize
+		rangeRows := make([][]share.Share, 0, endRow-startRow+1)
+		for row := startRow; row <= endRow; row++ {
+			if _, ok := rows[row]; !ok {
+				rows[row], err = share.FromBytes(eds.Row(uint(row)))
+				if err != nil {
+					return MultiShareProof{}, err
+				}
+			}
+			rangeRows = append(rangeRows, rows[row])
+		}
+
+		shareProofs, rawShares, err := CreateShareToRowRootProofs(
+			squareSize,
+			rangeRows,
+			edsRowRoots[startRow:endRow+1],
+			shareRange.Start%squareSize,
+			(shareRange.End-1)%squareSize,
+		)
+		if err != nil {
+			return MultiShareProof{}, err
+		}
+		ranges[i] = &ShareRangeProof{
+			Start:            uint32(shareRange.Start),
+			End:              uint32(shareRange.End),
+			NamespaceId:      namespace.ID(),
+			NamespaceVersion: uint32(namespace.Version()),
+			Data:             rawShares,
+			ShareProofs:      shareProofs,
+		}
+	}
+
+	rowIndexes := make([]int, 0, len(rows))
+	for row := range rows {
+		rowIndexes = append(rowIndexes, row)
+	}
+	sort.Ints(rowIndexes)
+
+	proof := MultiShareProof{
+		Ranges:    ranges,
+		Rows:      make([]uint32, len(rowIndexes)),
+		RowRoots:  make([][]byte, len(rowIndexes)),
+		RowProofs: make([]*Proof, len(rowIndexes)),
+	}
+	for i, row := range rowIndexes {
+		proof.Rows[i] = uint32(row)
+		proof.RowRoots[i] = edsRowRoots[row]
+		proof.RowProofs[i] = ProofFromMerkle(*allProofs[row])
+	}
+	return proof, nil
+}
+
+// Validate runs basic validations on the proof then verifies that all the
+// share ranges are included in the data square with the given data root. It
+// returns nil if the proof is valid.
+func (p MultiShareProof) Validate(root []byte) error {
+	if len(p.Ranges) == 0 {
+		return errors.New("empty multi share proof")
+	}
+	if len(p.RowRoots) != len(p.Rows) || len(p.RowProofs) != len(p.Rows) {
+		return fmt.Errorf("the number of rows %d, row roots %d and row proofs %d must be equal", len(p.Rows), len(p.RowRoots), len(p.RowProofs))
+	}
+
+	// the row roots and the column roots are the leaves of the data root so
+	// the original data square size is a quarter of the number of leaves.
+	var squareSize int
+	rowRoots := make(map[int][]byte, len(p.Rows))
+	for i, row := range p.Rows {
+		if i > 0 && row <= p.Rows[i-1] {
+			return errors.New("rows must be in strictly increasing order")
+		}
+		rowProof := p.RowProofs[i]
+		if rowProof == nil {
+			return fmt.Errorf("nil proof for row %d", row)
+		}
+		if rowProof.Index != int64(row) {
+			return fmt.Errorf("proof of row %d is for leaf %d", row, rowProof.Index)
+		}
+		if i == 0 {
+			if rowProof.Total <= 0 || rowProof.Total%4 != 0 || rowProof.Total/4 > math.MaxInt32 {
+				return fmt.Errorf("invalid number of data root leaves %d", rowProof.Total)
+			}
+			squareSize = int(rowProof.Total / 4)
+		} else if rowProof.Total != int64(4*squareSize) {
+			return errors.New("row proofs have different numbers of leaves")
+		}
+		if int(row) >= squareSize {
+			return fmt.Errorf("row %d is not in the original data square of size %d", row, squareSize)
+		}
+		if err := rowProof.Verify(root, p.RowRoots[i]); err != nil {
+			return fmt.Errorf("row %d proof failed to verify: %w", row, err)
+		}
+		rowRoots[int(row)] = p.RowRoots[i]
+	}
+
+	for i, rangeProof := range p.Ranges {
+		if rangeProof == nil {
+			return fmt.Errorf("nil proof for share range %d", i)
+		}
+		if err := rangeProof.verify(squareSize, rowRoots); err != nil {
+			return fmt.Errorf("share range %d [%d, %d): %w", i, rangeProof.Start, rangeProof.End, err)
+		}
+	}
+	return nil
+}
+
+// verify verifies the NMT proofs of the share range to the row roots, indexed
+// by row, of an original data square of size squareSize.
+func (p ShareRangeProof) verify(squareSize int, rowRoots map[int][]byte) error {
+	if p.End <= p.Start {
+		return errors.New("end share must be higher than the start share")
+	}
+	if int(p.End) > squareSize*squareSize {
+		return fmt.Errorf("end share is higher than the %d shares of the square", squareSize*squareSize)
+	}
+	if p.NamespaceVersion > math.MaxUint8 {
+		return fmt.Errorf("invalid namespace version %d", p.NamespaceVersion)
+	}
+	if len(p.Data) != int(p.End-p.Start) {
+		return fmt.Errorf("the number of shares %d must equal the range length %d", len(p.Data), p.End-p.Start)
+	}
+
+	startRow := int(p.Start) / squareSize
+	endRow := int(p.End-1) / squareSize
+	if len(p.ShareProofs) != endRow-startRow+1 {
+		return fmt.Errorf("the number of share proofs %d must equal the number of rows %d", len(p.ShareProofs), endRow-startRow+1)
+	}
+
+	namespace := append([]byte{uint8(p.NamespaceVersion)}, p.NamespaceId...)
+	cursor := 0
+	for i, proof := range p.ShareProofs {
+		row := startRow + i
+		rowRoot, ok := rowRoots[row]
+		if !ok {
+			return fmt.Errorf("missing root of row %d", row)
+		}
+		if proof == nil {
+			return fmt.Errorf("nil share proof for row %d", row)
+		}
+
+		// the expected leaves of the row that are part of the range
+		startLeaf, endLeaf := 0, squareSize
+		if row == startRow {
+			startLeaf = int(p.Start) % squareSize
+		}
+		if row == endRow {
+			endLeaf = int(p.End-1)%squareSize + 1
+		}
+		if int(proof.Start) != startLeaf || int(proof.End) != endLeaf {
+			return fmt.Errorf("share proof of row %d is for leaves [%d, %d) instead of [%d, %d)", row, proof.Start, proof.End, startLeaf, endLeaf)
+		}
+
+		nmtProof := nmt.NewInclusionProof(startLeaf, endLeaf, proof.Nodes, true)
+		sharesUsed := endLeaf - startLeaf
+		if !nmtProof.VerifyInclusion(appconsts.NewBaseHashFunc(), namespace, p.Data[cursor:cursor+sharesUsed], rowRoot) {
+			return fmt.Errorf("share proof of row %d failed to verify", row)
+		}
+		cursor += sharesUsed
+	}
+	return nil
+}
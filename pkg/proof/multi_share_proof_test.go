@@ -0,0 +1,196 @@
+package proof_test
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestMultiShareInclusionProof(t *testing.T) {
+	_, dataSquare, dataRoot := newMultiShareTestSquare(t)
+	ns1Range := namespaceRange(t, dataSquare, multiShareTestNamespace(1))
+	ns3Range := namespaceRange(t, dataSquare, multiShareTestNamespace(3))
+	shareRanges := []share.Range{
+		share.NewRange(0, 2),
+		ns1Range,
+		ns3Range,
+	}
+
+	p, err := proof.NewMultiShareInclusionProof(dataSquare, shareRanges)
+	require.NoError(t, err)
+	require.NoError(t, p.Validate(dataRoot))
+	require.Len(t, p.Ranges, len(shareRanges))
+
+	// the row roots are only included once
+	squareSize := square.Size(len(dataSquare))
+	rows := map[int]struct{}{}
+	for _, r := range shareRanges {
+		for row := r.Start / squareSize; row <= (r.End-1)/squareSize; row++ {
+			rows[row] = struct{}{}
+		}
+	}
+	assert.Len(t, p.Rows, len(rows))
+	assert.Len(t, p.RowRoots, len(rows))
+	assert.Len(t, p.RowProofs, len(rows))
+
+	for i, r := range shareRanges {
+		assert.Equal(t, share.ToBytes(dataSquare[r.Start:r.End]), p.Ranges[i].Data)
+	}
+
+	// each range proof matches the single range share proof
+	single, err := proof.NewShareInclusionProof(dataSquare, multiShareTestNamespace(1), ns1Range)
+	require.NoError(t, err)
+	assert.Equal(t, single.Data, p.Ranges[1].Data)
+	assert.Equal(t, single.ShareProofs, p.Ranges[1].ShareProofs)
+}
+
+func TestMultiShareProofValidateInvalid(t *testing.T) {
+	_, dataSquare, dataRoot := newMultiShareTestSquare(t)
+	shareRanges := []share.Range{
+		share.NewRange(0, 2),
+		namespaceRange(t, dataSquare, multiShareTestNamespace(2)),
+	}
+
+	tests := []struct {
+		name   string
+		modify func(p *proof.MultiShareProof)
+	}{
+		{
+			name:   "no ranges",
+			modify: func(p *proof.MultiShareProof) { p.Ranges = nil },
+		},
+		{
+			name:   "modified share",
+			modify: func(p *proof.MultiShareProof) { p.Ranges[1].Data[0] = tmrand.Bytes(share.ShareSize) },
+		},
+		{
+			name:   "shifted range",
+			modify: func(p *proof.MultiShareProof) { p.Ranges[0].Start++; p.Ranges[0].End++ },
+		},
+		{
+			name:   "other namespace",
+			modify: func(p *proof.MultiShareProof) { p.Ranges[1].NamespaceId = multiShareTestNamespace(3).ID() },
+		},
+		{
+			name: "missing row",
+			modify: func(p *proof.MultiShareProof) {
+				p.Rows, p.RowRoots, p.RowProofs = p.Rows[1:], p.RowRoots[1:], p.RowProofs[1:]
+			},
+		},
+		{
+			name:   "row proof for another row",
+			modify: func(p *proof.MultiShareProof) { p.Rows[0]++ },
+		},
+		{
+			name:   "modified row root",
+			modify: func(p *proof.MultiShareProof) { p.RowRoots[0] = tmrand.Bytes(len(p.RowRoots[0])) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := proof.NewMultiShareInclusionProof(dataSquare, shareRanges)
+			require.NoError(t, err)
+			require.NoError(t, p.Validate(dataRoot))
+			tt.modify(&p)
+			assert.Error(t, p.Validate(dataRoot))
+		})
+	}
+
+	p, err := proof.NewMultiShareInclusionProof(dataSquare, shareRanges)
+	require.NoError(t, err)
+	assert.Error(t, p.Validate(tmrand.Bytes(len(dataRoot))))
+}
+
+func TestNewMultiShareInclusionProofInvalidRanges(t *testing.T) {
+	_, dataSquare, _ := newMultiShareTestSquare(t)
+	ns1Range := namespaceRange(t, dataSquare, multiShareTestNamespace(1))
+
+	_, err := proof.NewMultiShareInclusionProof(dataSquare, nil)
+	assert.Error(t, err)
+	// the range spans several namespaces
+	_, err = proof.NewMultiShareInclusionProof(dataSquare, []share.Range{share.NewRange(ns1Range.Start, ns1Range.End+1)})
+	assert.Error(t, err)
+	_, err = proof.NewMultiShareInclusionProof(dataSquare, []share.Range{share.NewRange(0, len(dataSquare)+1)})
+	assert.Error(t, err)
+}
+
+func TestQueryMultiShareInclusionProof(t *testing.T) {
+	txs, dataSquare, dataRoot := newMultiShareTestSquare(t)
+	ns2Range := namespaceRange(t, dataSquare, multiShareTestNamespace(2))
+
+	block := tmproto.Block{
+		Header: tmproto.Header{Version: tmversion.Consensus{App: appconsts.LatestVersion}},
+		Data:   tmproto.Data{Txs: txs},
+	}
+	rawBlock, err := block.Marshal()
+	require.NoError(t, err)
+
+	path := []string{"0", "2", strconv.Itoa(ns2Range.Start), strconv.Itoa(ns2Range.End)}
+	rawProof, err := proof.QueryMultiShareInclusionProof(sdk.Context{}, path, abci.RequestQuery{Data: rawBlock})
+	require.NoError(t, err)
+
+	var p proof.MultiShareProof
+	require.NoError(t, p.Unmarshal(rawProof))
+	require.Len(t, p.Ranges, 2)
+	assert.NoError(t, p.Validate(dataRoot))
+
+	_, err = proof.QueryMultiShareInclusionProof(sdk.Context{}, []string{"0"}, abci.RequestQuery{Data: rawBlock})
+	assert.Error(t, err)
+	_, err = proof.QueryMultiShareInclusionProof(sdk.Context{}, []string{"0", "-2"}, abci.RequestQuery{Data: rawBlock})
+	assert.Error(t, err)
+}
+
+func multiShareTestNamespace(b byte) share.Namespace {
+	return share.MustNewV0Namespace(bytes.Repeat([]byte{b}, share.NamespaceVersionZeroIDSize))
+}
+
+// newMultiShareTestSquare returns the transactions of a square containing
+// random transactions and blobs in three namespaces, along with the square and
+// its data root.
+func newMultiShareTestSquare(t *testing.T) ([][]byte, square.Square, []byte) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	namespaces := []share.Namespace{multiShareTestNamespace(1), multiShareTestNamespace(2), multiShareTestNamespace(3)}
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, namespaces, []int{5000, 500, 5000})
+	txs := append(testfactory.GenerateRandomTxs(50, 500), blobTxs...).ToSliceOfBytes()
+
+	dataSquare, err := square.Construct(txs, appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	return txs, dataSquare, dah.Hash()
+}
+
+// namespaceRange returns the range of the shares of the namespace in the
+// square.
+func namespaceRange(t *testing.T, dataSquare square.Square, namespace share.Namespace) share.Range {
+	start, end := -1, -1
+	for i, sh := range dataSquare {
+		if sh.Namespace().Equals(namespace) {
+			if start == -1 {
+				start = i
+			}
+			end = i + 1
+		}
+	}
+	require.NotEqual(t, -1, start, "namespace not found in square")
+	return share.NewRange(start, end)
+}
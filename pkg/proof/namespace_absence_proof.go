@@ -0,0 +1,121 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// NewNamespaceAbsenceProof takes an extended data square and returns a proof
+// that the namespace has no shares in it. The proof contains an NMT proof of
+// absence for each row whose namespace range contains the namespace. It
+// returns an error if the namespace has shares in the square.
+func NewNamespaceAbsenceProof(eds *rsmt2d.ExtendedDataSquare, namespace share.Namespace) (NamespaceAbsenceProof, error) {
+	edsRowRoots, err := eds.RowRoots()
+	if err != nil {
+		return NamespaceAbsenceProof{}, err
+	}
+
+	proof := NamespaceAbsenceProof{
+		NamespaceId:      namespace.ID(),
+		NamespaceVersion: uint32(namespace.Version()),
+	}
+	for _, row := range rowsInNamespaceRange(edsRowRoots, namespace.Bytes()) {
+		tree, err := wrapper.NewEDSAxisTree(eds, rsmt2d.Row, uint(row))
+		if err != nil {
+			return NamespaceAbsenceProof{}, err
+		}
+		root, err := tree.Root()
+		if err != nil {
+			return NamespaceAbsenceProof{}, err
+		}
+		if !bytes.Equal(edsRowRoots[row], root) {
+			return NamespaceAbsenceProof{}, errors.New("eds row root is different than tree root")
+		}
+
+		nmtProof, err := tree.ProveNamespace(namespace.Bytes())
+		if err != nil {
+			return NamespaceAbsenceProof{}, err
+		}
+		if !nmtProof.IsOfAbsence() {
+			return NamespaceAbsenceProof{}, fmt.Errorf("namespace %x has shares in row %d", namespace.Bytes(), row)
+		}
+
+		proof.Rows = append(proof.Rows, uint32(row))
+		proof.AbsenceProofs = append(proof.AbsenceProofs, &NMTProof{
+			Start:    int32(nmtProof.Start()),
+			End:      int32(nmtProof.End()),
+			Nodes:    nmtProof.Nodes(),
+			LeafHash: nmtProof.LeafHash(),
+		})
+	}
+	return proof, nil
+}
+
+// Validate runs basic validations on the proof then verifies that the
+// namespace has no shares in the data square committed to by the data
+// availability header. It returns nil if the proof is valid.
+func (p NamespaceAbsenceProof) Validate(dah *da.DataAvailabilityHeader) error {
+	if err := dah.ValidateBasic(); err != nil {
+		return err
+	}
+	if p.NamespaceVersion > math.MaxUint8 {
+		return fmt.Errorf("invalid namespace version %d", p.NamespaceVersion)
+	}
+	namespace, err := share.NewNamespaceFromBytes(append([]byte{uint8(p.NamespaceVersion)}, p.NamespaceId...))
+	if err != nil {
+		return err
+	}
+	if len(p.AbsenceProofs) != len(p.Rows) {
+		return fmt.Errorf("the number of rows %d and absence proofs %d must be equal", len(p.Rows), len(p.AbsenceProofs))
+	}
+
+	// all the rows whose namespace range contains the namespace must be proven
+	// to not contain it.
+	expectedRows := rowsInNamespaceRange(dah.RowRoots, namespace.Bytes())
+	if len(expectedRows) != len(p.Rows) {
+		return fmt.Errorf("the namespace is in the range of %d rows but the proof covers %d rows", len(expectedRows), len(p.Rows))
+	}
+	for i, row := range p.Rows {
+		if int(row) != expectedRows[i] {
+			return fmt.Errorf("expected a proof for row %d instead of row %d", expectedRows[i], row)
+		}
+		proof := p.AbsenceProofs[i]
+		if proof == nil {
+			return fmt.Errorf("nil absence proof for row %d", row)
+		}
+		if len(proof.LeafHash) == 0 {
+			return fmt.Errorf("proof of row %d is not a proof of absence", row)
+		}
+		nmtProof := nmt.NewAbsenceProof(int(proof.Start), int(proof.End), proof.Nodes, proof.LeafHash, true)
+		if !nmtProof.VerifyNamespace(appconsts.NewBaseHashFunc(), namespace.Bytes(), nil, dah.RowRoots[row]) {
+			return fmt.Errorf("absence proof of row %d failed to verify", row)
+		}
+	}
+	return nil
+}
+
+// rowsInNamespaceRange returns the indexes of the rows whose namespace range
+// contains the namespace.
+func rowsInNamespaceRange(rowRoots [][]byte, namespace []byte) []int {
+	var rows []int
+	for i, root := range rowRoots {
+		if len(root) < 2*share.NamespaceSize {
+			continue
+		}
+		minNamespace := nmt.MinNamespace(root, share.NamespaceSize)
+		maxNamespace := nmt.MaxNamespace(root, share.NamespaceSize)
+		if bytes.Compare(minNamespace, namespace) <= 0 && bytes.Compare(namespace, maxNamespace) <= 0 {
+			rows = append(rows, i)
+		}
+	}
+	return rows
+}
@@ -0,0 +1,161 @@
+package proof_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestNamespaceAbsenceProof(t *testing.T) {
+	_, eds, dah := newAbsenceTestSquare(t)
+
+	tests := []struct {
+		name      string
+		namespace share.Namespace
+	}{
+		{
+			name:      "namespace between the blobs",
+			namespace: multiShareTestNamespace(2),
+		},
+		{
+			name:      "namespace before the blobs",
+			namespace: share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize-1)),
+		},
+		{
+			name:      "namespace after the blobs",
+			namespace: multiShareTestNamespace(4),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := proof.NewNamespaceAbsenceProof(eds, tt.namespace)
+			require.NoError(t, err)
+			assert.NotEmpty(t, p.Rows)
+			assert.NoError(t, p.Validate(&dah))
+		})
+	}
+
+	// the namespace is present in the square
+	_, err := proof.NewNamespaceAbsenceProof(eds, multiShareTestNamespace(1))
+	assert.Error(t, err)
+}
+
+func TestNamespaceAbsenceProofValidateInvalid(t *testing.T) {
+	_, eds, dah := newAbsenceTestSquare(t)
+	namespace := multiShareTestNamespace(2)
+
+	tests := []struct {
+		name   string
+		modify func(p *proof.NamespaceAbsenceProof)
+	}{
+		{
+			name:   "missing row",
+			modify: func(p *proof.NamespaceAbsenceProof) { p.Rows, p.AbsenceProofs = p.Rows[1:], p.AbsenceProofs[1:] },
+		},
+		{
+			name:   "proof for another row",
+			modify: func(p *proof.NamespaceAbsenceProof) { p.Rows[0]++ },
+		},
+		{
+			name:   "nil proof",
+			modify: func(p *proof.NamespaceAbsenceProof) { p.AbsenceProofs[0] = nil },
+		},
+		{
+			name:   "no leaf hash",
+			modify: func(p *proof.NamespaceAbsenceProof) { p.AbsenceProofs[0].LeafHash = nil },
+		},
+		{
+			name: "modified leaf hash",
+			modify: func(p *proof.NamespaceAbsenceProof) {
+				leafHash := p.AbsenceProofs[0].LeafHash
+				copy(leafHash[len(leafHash)-8:], tmrand.Bytes(8))
+			},
+		},
+		{
+			name:   "present namespace",
+			modify: func(p *proof.NamespaceAbsenceProof) { p.NamespaceId = multiShareTestNamespace(1).ID() },
+		},
+		{
+			name:   "invalid namespace version",
+			modify: func(p *proof.NamespaceAbsenceProof) { p.NamespaceVersion = 256 },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := proof.NewNamespaceAbsenceProof(eds, namespace)
+			require.NoError(t, err)
+			require.NoError(t, p.Validate(&dah))
+			tt.modify(&p)
+			assert.Error(t, p.Validate(&dah))
+		})
+	}
+
+	// the proof doesn't verify against another square
+	p, err := proof.NewNamespaceAbsenceProof(eds, namespace)
+	require.NoError(t, err)
+	_, _, otherDAH := newAbsenceTestSquare(t)
+	assert.Error(t, p.Validate(&otherDAH))
+}
+
+func TestQueryNamespaceAbsenceProof(t *testing.T) {
+	txs, _, dah := newAbsenceTestSquare(t)
+
+	block := tmproto.Block{
+		Header: tmproto.Header{Version: tmversion.Consensus{App: appconsts.LatestVersion}},
+		Data:   tmproto.Data{Txs: txs},
+	}
+	rawBlock, err := block.Marshal()
+	require.NoError(t, err)
+
+	path := []string{hex.EncodeToString(multiShareTestNamespace(2).Bytes())}
+	rawProof, err := proof.QueryNamespaceAbsenceProof(sdk.Context{}, path, abci.RequestQuery{Data: rawBlock})
+	require.NoError(t, err)
+
+	var p proof.NamespaceAbsenceProof
+	require.NoError(t, p.Unmarshal(rawProof))
+	assert.NoError(t, p.Validate(&dah))
+
+	path = []string{hex.EncodeToString(multiShareTestNamespace(1).Bytes())}
+	_, err = proof.QueryNamespaceAbsenceProof(sdk.Context{}, path, abci.RequestQuery{Data: rawBlock})
+	assert.Error(t, err)
+	_, err = proof.QueryNamespaceAbsenceProof(sdk.Context{}, []string{"not hex"}, abci.RequestQuery{Data: rawBlock})
+	assert.Error(t, err)
+	_, err = proof.QueryNamespaceAbsenceProof(sdk.Context{}, []string{"00"}, abci.RequestQuery{Data: rawBlock})
+	assert.Error(t, err)
+}
+
+// newAbsenceTestSquare returns the transactions of a square containing random
+// transactions and blobs in the namespaces 1 and 3, along with the extended
+// square and its data availability header.
+func newAbsenceTestSquare(t *testing.T) ([][]byte, *rsmt2d.ExtendedDataSquare, da.DataAvailabilityHeader) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	namespaces := []share.Namespace{multiShareTestNamespace(1), multiShareTestNamespace(3)}
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, namespaces, []int{5000, 5000})
+	txs := append(testfactory.GenerateRandomTxs(50, 500), blobTxs...).ToSliceOfBytes()
+
+	dataSquare, err := square.Construct(txs, appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	return txs, eds, dah
+}
@@ -272,7 +272,7 @@ func TestQueryTxInclusionProofRejectsNegativeValues(t *testing.T) {
 	path := []string{"-2"}
 	req := abci.RequestQuery{Data: []byte{}}
 	ctx := sdk.Context{}
-	rawProof, err := proof.QueryTxInclusionProof(ctx, path, req)
+	rawProof, err := proof.QueryTxInclusionProof(ctx, path, req) //nolint:staticcheck
 	if err == nil {
 		t.Fatal("expected a non-nil error")
 	}
@@ -283,3 +283,22 @@ func TestQueryTxInclusionProofRejectsNegativeValues(t *testing.T) {
 		t.Fatal("no rawProof expected")
 	}
 }
+
+func TestNewRowProof(t *testing.T) {
+	_, dataSquare, dataRoot := newMultiShareTestSquare(t)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	squareSize := uint32(dataSquare.Size())
+
+	rowProof, err := proof.NewRowProof(eds, 1, squareSize-1)
+	require.NoError(t, err)
+	assert.Len(t, rowProof.RowRoots, int(squareSize-1))
+	assert.Equal(t, dataRoot, rowProof.Root)
+	assert.NoError(t, rowProof.Validate(dataRoot))
+
+	_, err = proof.NewRowProof(eds, 2, 1)
+	assert.Error(t, err)
+	// the rows of the extended data square are not in the original data square
+	_, err = proof.NewRowProof(eds, 0, squareSize)
+	assert.Error(t, err)
+}
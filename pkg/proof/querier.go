@@ -2,11 +2,13 @@ package proof
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"strconv"
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
 	"github.com/celestiaorg/go-square/v2"
 	"github.com/celestiaorg/go-square/v2/share"
 
@@ -16,6 +18,10 @@ import (
 	"github.com/tendermint/tendermint/types"
 )
 
+// TxInclusionQueryPath is the custom ABCI query path of QueryTxInclusionProof.
+//
+// Deprecated: use the TxInclusionProof method of the
+// celestia.core.v1.proof.Query gRPC service instead.
 const TxInclusionQueryPath = "txInclusionProof"
 
 // Querier defines the logic performed when the ABCI client using the Query
@@ -25,6 +31,9 @@ const TxInclusionQueryPath = "txInclusionProof"
 //
 // example path for proving the third transaction in that block:
 // custom/txInclusionProof/3
+//
+// Deprecated: use the TxInclusionProof method of the
+// celestia.core.v1.proof.Query gRPC service instead.
 func QueryTxInclusionProof(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
 	// parse the index from the path
 	if len(path) != 1 {
@@ -63,12 +72,20 @@ func QueryTxInclusionProof(_ sdk.Context, path []string, req abci.RequestQuery)
 	return rawShareProof, nil
 }
 
+// ShareInclusionQueryPath is the custom ABCI query path of
+// QueryShareInclusionProof.
+//
+// Deprecated: use the ShareInclusionProof method of the
+// celestia.core.v1.proof.Query gRPC service instead.
 const ShareInclusionQueryPath = "shareInclusionProof"
 
 // QueryShareInclusionProof defines the logic performed when querying for the
 // inclusion proofs of a set of shares to the data root. The share range should
 // be appended to the path. Example path for proving the set of shares [3, 5]:
 // custom/shareInclusionProof/3/5
+//
+// Deprecated: use the ShareInclusionProof method of the
+// celestia.core.v1.proof.Query gRPC service instead.
 func QueryShareInclusionProof(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
 	// parse the share range from the path
 	if len(path) != 2 {
@@ -127,6 +144,139 @@ func QueryShareInclusionProof(_ sdk.Context, path []string, req abci.RequestQuer
 	return rawShareProof, nil
 }
 
+// MultiShareInclusionQueryPath is the custom ABCI query path of
+// QueryMultiShareInclusionProof.
+//
+// Deprecated: use the MultiShareProof method of the
+// celestia.core.v1.proof.Query gRPC service instead.
+const MultiShareInclusionQueryPath = "multiShareInclusionProof"
+
+// QueryMultiShareInclusionProof defines the logic performed when querying for
+// the inclusion proof of several share ranges to the data root. The begin and
+// end of each range should be appended to the path. Example path for proving
+// the sets of shares [3, 5) and [10, 12):
+// custom/multiShareInclusionProof/3/5/10/12
+//
+// Deprecated: use the MultiShareProof method of the
+// celestia.core.v1.proof.Query gRPC service instead.
+func QueryMultiShareInclusionProof(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+	// parse the share ranges from the path
+	if len(path) == 0 || len(path)%2 != 0 {
+		return nil, fmt.Errorf("expected a non-zero even query path length, actual: %d", len(path))
+	}
+	shareRanges := make([]share.Range, 0, len(path)/2)
+	for i := 0; i < len(path); i += 2 {
+		beginShare, err := strconv.ParseInt(path[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		endShare, err := strconv.ParseInt(path[i+1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		begin, err := safeConvertInt64ToInt(beginShare)
+		if err != nil {
+			return nil, err
+		}
+		end, err := safeConvertInt64ToInt(endShare)
+		if err != nil {
+			return nil, err
+		}
+		shareRanges = append(shareRanges, share.NewRange(begin, end))
+	}
+
+	// unmarshal the block data that is passed from the ABCI client
+	pbb := new(tmproto.Block)
+	err := pbb.Unmarshal(req.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error reading block: %w", err)
+	}
+
+	// construct the data square from the block data. As we don't have
+	// access to the application's state machine we use the upper bound
+	// square size instead of the square size dictated from governance
+	dataSquare, err := square.Construct(pbb.Data.Txs, appconsts.SquareSizeUpperBound(pbb.Header.Version.App), appconsts.SubtreeRootThreshold(pbb.Header.Version.App))
+	if err != nil {
+		return nil, err
+	}
+
+	// create and marshal the multi share inclusion proof, which we return in
+	// the form of []byte. The share ranges are validated when building it.
+	multiShareProof, err := NewMultiShareInclusionProof(dataSquare, shareRanges)
+	if err != nil {
+		return nil, err
+	}
+
+	rawMultiShareProof, err := multiShareProof.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return rawMultiShareProof, nil
+}
+
+// NamespaceAbsenceQueryPath is the custom ABCI query path of
+// QueryNamespaceAbsenceProof.
+//
+// Deprecated: use the NamespaceAbsenceProof method of the
+// celestia.core.v1.proof.Query gRPC service instead.
+const NamespaceAbsenceQueryPath = "namespaceAbsenceProof"
+
+// QueryNamespaceAbsenceProof defines the logic performed when querying for the
+// proof that a namespace has no shares in the data square. The hex encoded
+// namespace, including its version, should be appended to the path. Example
+// path: custom/namespaceAbsenceProof/<namespace>
+//
+// Deprecated: use the NamespaceAbsenceProof method of the
+// celestia.core.v1.proof.Query gRPC service instead.
+func QueryNamespaceAbsenceProof(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+	// parse the namespace from the path
+	if len(path) != 1 {
+		return nil, fmt.Errorf("expected query path length: 1 actual: %d ", len(path))
+	}
+	rawNamespace, err := hex.DecodeString(path[0])
+	if err != nil {
+		return nil, err
+	}
+	namespace, err := share.NewNamespaceFromBytes(rawNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// unmarshal the block data that is passed from the ABCI client
+	pbb := new(tmproto.Block)
+	err = pbb.Unmarshal(req.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error reading block: %w", err)
+	}
+
+	// construct the data square from the block data. As we don't have
+	// access to the application's state machine we use the upper bound
+	// square size instead of the square size dictated from governance
+	dataSquare, err := square.Construct(pbb.Data.Txs, appconsts.SquareSizeUpperBound(pbb.Header.Version.App), appconsts.SubtreeRootThreshold(pbb.Header.Version.App))
+	if err != nil {
+		return nil, err
+	}
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	if err != nil {
+		return nil, err
+	}
+
+	// create and marshal the namespace absence proof, which we return in the
+	// form of []byte
+	absenceProof, err := NewNamespaceAbsenceProof(eds, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	rawAbsenceProof, err := absenceProof.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return rawAbsenceProof, nil
+}
+
 // ParseNamespace validates the share range, checks if it only contains one namespace and returns
 // that namespace ID.
 // The provided range, defined by startShare and endShare, is end-exclusive.
@@ -0,0 +1,3228 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/proof/query.proto
+
+package proof
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryBlobProofRequest is the request type for the BlobProof gRPC method.
+type QueryBlobProofRequest struct {
+	// height is the height of the block containing the transaction.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// tx_hash is the hex encoded hash of the transaction.
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// blob_index is the index of the blob in the transaction.
+	BlobIndex uint32 `protobuf:"varint,3,opt,name=blob_index,json=blobIndex,proto3" json:"blob_index,omitempty"`
+}
+
+func (m *QueryBlobProofRequest) Reset()         { *m = QueryBlobProofRequest{} }
+func (m *QueryBlobProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobProofRequest) ProtoMessage()    {}
+func (*QueryBlobProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{0}
+}
+func (m *QueryBlobProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobProofRequest.Merge(m, src)
+}
+func (m *QueryBlobProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobProofRequest proto.InternalMessageInfo
+
+func (m *QueryBlobProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryBlobProofRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *QueryBlobProofRequest) GetBlobIndex() uint32 {
+	if m != nil {
+		return m.BlobIndex
+	}
+	return 0
+}
+
+// QueryBlobProofResponse is the response type for the BlobProof gRPC method.
+type QueryBlobProofResponse struct {
+	// start is the index of the first share of the blob in the original data
+	// square.
+	Start uint32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// end is the end exclusive index of the blob shares in the original data
+	// square.
+	End   uint32      `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Proof *ShareProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryBlobProofResponse) Reset()         { *m = QueryBlobProofResponse{} }
+func (m *QueryBlobProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobProofResponse) ProtoMessage()    {}
+func (*QueryBlobProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{1}
+}
+func (m *QueryBlobProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobProofResponse.Merge(m, src)
+}
+func (m *QueryBlobProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobProofResponse proto.InternalMessageInfo
+
+func (m *QueryBlobProofResponse) GetStart() uint32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *QueryBlobProofResponse) GetEnd() uint32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *QueryBlobProofResponse) GetProof() *ShareProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// QueryTxInclusionProofRequest is the request type for the TxInclusionProof
+// gRPC method.
+type QueryTxInclusionProofRequest struct {
+	// height is the height of the block containing the transaction.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// tx_index is the index of the transaction in the block.
+	TxIndex uint32 `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+}
+
+func (m *QueryTxInclusionProofRequest) Reset()         { *m = QueryTxInclusionProofRequest{} }
+func (m *QueryTxInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxInclusionProofRequest) ProtoMessage()    {}
+func (*QueryTxInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{2}
+}
+func (m *QueryTxInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxInclusionProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxInclusionProofRequest.Merge(m, src)
+}
+func (m *QueryTxInclusionProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxInclusionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxInclusionProofRequest proto.InternalMessageInfo
+
+func (m *QueryTxInclusionProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryTxInclusionProofRequest) GetTxIndex() uint32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+// QueryTxInclusionProofResponse is the response type for the TxInclusionProof
+// gRPC method.
+type QueryTxInclusionProofResponse struct {
+	// start is the index of the first share of the transaction in the original
+	// data square.
+	Start uint32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// end is the end exclusive index of the transaction shares in the original
+	// data square.
+	End   uint32      `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Proof *ShareProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryTxInclusionProofResponse) Reset()         { *m = QueryTxInclusionProofResponse{} }
+func (m *QueryTxInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxInclusionProofResponse) ProtoMessage()    {}
+func (*QueryTxInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{3}
+}
+func (m *QueryTxInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxInclusionProofResponse.Merge(m, src)
+}
+func (m *QueryTxInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxInclusionProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxInclusionProofResponse proto.InternalMessageInfo
+
+func (m *QueryTxInclusionProofResponse) GetStart() uint32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *QueryTxInclusionProofResponse) GetEnd() uint32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *QueryTxInclusionProofResponse) GetProof() *ShareProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// QueryShareInclusionProofRequest is the request type for the
+// ShareInclusionProof gRPC method.
+type QueryShareInclusionProofRequest struct {
+	// height is the height of the block containing the shares.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// start is the index of the first share in the original data square.
+	Start uint32 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	// end is the end exclusive index of the shares in the original data square.
+	End uint32 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *QueryShareInclusionProofRequest) Reset()         { *m = QueryShareInclusionProofRequest{} }
+func (m *QueryShareInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryShareInclusionProofRequest) ProtoMessage()    {}
+func (*QueryShareInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{4}
+}
+func (m *QueryShareInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryShareInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShareInclusionProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryShareInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShareInclusionProofRequest.Merge(m, src)
+}
+func (m *QueryShareInclusionProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryShareInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShareInclusionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryShareInclusionProofRequest proto.InternalMessageInfo
+
+func (m *QueryShareInclusionProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryShareInclusionProofRequest) GetStart() uint32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *QueryShareInclusionProofRequest) GetEnd() uint32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+// QueryShareInclusionProofResponse is the response type for the
+// ShareInclusionProof gRPC method.
+type QueryShareInclusionProofResponse struct {
+	Proof *ShareProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryShareInclusionProofResponse) Reset()         { *m = QueryShareInclusionProofResponse{} }
+func (m *QueryShareInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryShareInclusionProofResponse) ProtoMessage()    {}
+func (*QueryShareInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{5}
+}
+func (m *QueryShareInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryShareInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShareInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryShareInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShareInclusionProofResponse.Merge(m, src)
+}
+func (m *QueryShareInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryShareInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShareInclusionProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryShareInclusionProofResponse proto.InternalMessageInfo
+
+func (m *QueryShareInclusionProofResponse) GetProof() *ShareProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// QueryRowInclusionProofRequest is the request type for the RowInclusionProof
+// gRPC method.
+type QueryRowInclusionProofRequest struct {
+	// height is the height of the block containing the rows.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// start_row is the index of the first row in the original data square.
+	StartRow uint32 `protobuf:"varint,2,opt,name=start_row,json=startRow,proto3" json:"start_row,omitempty"`
+	// end_row is the end inclusive index of the rows in the original data
+	// square.
+	EndRow uint32 `protobuf:"varint,3,opt,name=end_row,json=endRow,proto3" json:"end_row,omitempty"`
+}
+
+func (m *QueryRowInclusionProofRequest) Reset()         { *m = QueryRowInclusionProofRequest{} }
+func (m *QueryRowInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRowInclusionProofRequest) ProtoMessage()    {}
+func (*QueryRowInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{6}
+}
+func (m *QueryRowInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRowInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRowInclusionProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRowInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRowInclusionProofRequest.Merge(m, src)
+}
+func (m *QueryRowInclusionProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRowInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRowInclusionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRowInclusionProofRequest proto.InternalMessageInfo
+
+func (m *QueryRowInclusionProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryRowInclusionProofRequest) GetStartRow() uint32 {
+	if m != nil {
+		return m.StartRow
+	}
+	return 0
+}
+
+func (m *QueryRowInclusionProofRequest) GetEndRow() uint32 {
+	if m != nil {
+		return m.EndRow
+	}
+	return 0
+}
+
+// QueryRowInclusionProofResponse is the response type for the
+// RowInclusionProof gRPC method.
+type QueryRowInclusionProofResponse struct {
+	Proof *RowProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryRowInclusionProofResponse) Reset()         { *m = QueryRowInclusionProofResponse{} }
+func (m *QueryRowInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRowInclusionProofResponse) ProtoMessage()    {}
+func (*QueryRowInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{7}
+}
+func (m *QueryRowInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRowInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRowInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRowInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRowInclusionProofResponse.Merge(m, src)
+}
+func (m *QueryRowInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRowInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRowInclusionProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRowInclusionProofResponse proto.InternalMessageInfo
+
+func (m *QueryRowInclusionProofResponse) GetProof() *RowProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// QueryMultiShareProofRequest is the request type for the MultiShareProof gRPC
+// method.
+type QueryMultiShareProofRequest struct {
+	// height is the height of the block containing the shares.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// ranges are the share ranges to prove.
+	Ranges []*ShareRange `protobuf:"bytes,2,rep,name=ranges,proto3" json:"ranges,omitempty"`
+}
+
+func (m *QueryMultiShareProofRequest) Reset()         { *m = QueryMultiShareProofRequest{} }
+func (m *QueryMultiShareProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMultiShareProofRequest) ProtoMessage()    {}
+func (*QueryMultiShareProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{8}
+}
+func (m *QueryMultiShareProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultiShareProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultiShareProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMultiShareProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultiShareProofRequest.Merge(m, src)
+}
+func (m *QueryMultiShareProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultiShareProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultiShareProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMultiShareProofRequest proto.InternalMessageInfo
+
+func (m *QueryMultiShareProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryMultiShareProofRequest) GetRanges() []*ShareRange {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+// ShareRange is a range of shares of the original data square.
+type ShareRange struct {
+	// start is the index of the first share in the original data square.
+	Start uint32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// end is the end exclusive index of the shares in the original data square.
+	End uint32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *ShareRange) Reset()         { *m = ShareRange{} }
+func (m *ShareRange) String() string { return proto.CompactTextString(m) }
+func (*ShareRange) ProtoMessage()    {}
+func (*ShareRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{9}
+}
+func (m *ShareRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShareRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShareRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShareRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareRange.Merge(m, src)
+}
+func (m *ShareRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShareRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareRange proto.InternalMessageInfo
+
+func (m *ShareRange) GetStart() uint32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *ShareRange) GetEnd() uint32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+// QueryMultiShareProofResponse is the response type for the MultiShareProof
+// gRPC method.
+type QueryMultiShareProofResponse struct {
+	Proof *MultiShareProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryMultiShareProofResponse) Reset()         { *m = QueryMultiShareProofResponse{} }
+func (m *QueryMultiShareProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMultiShareProofResponse) ProtoMessage()    {}
+func (*QueryMultiShareProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{10}
+}
+func (m *QueryMultiShareProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultiShareProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultiShareProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMultiShareProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultiShareProofResponse.Merge(m, src)
+}
+func (m *QueryMultiShareProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultiShareProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultiShareProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMultiShareProofResponse proto.InternalMessageInfo
+
+func (m *QueryMultiShareProofResponse) GetProof() *MultiShareProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// QueryNamespaceAbsenceProofRequest is the request type for the
+// NamespaceAbsenceProof gRPC method.
+type QueryNamespaceAbsenceProofRequest struct {
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// namespace is the hex encoded namespace, including its version.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *QueryNamespaceAbsenceProofRequest) Reset()         { *m = QueryNamespaceAbsenceProofRequest{} }
+func (m *QueryNamespaceAbsenceProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceAbsenceProofRequest) ProtoMessage()    {}
+func (*QueryNamespaceAbsenceProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{11}
+}
+func (m *QueryNamespaceAbsenceProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceAbsenceProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceAbsenceProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespaceAbsenceProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceAbsenceProofRequest.Merge(m, src)
+}
+func (m *QueryNamespaceAbsenceProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceAbsenceProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceAbsenceProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespaceAbsenceProofRequest proto.InternalMessageInfo
+
+func (m *QueryNamespaceAbsenceProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryNamespaceAbsenceProofRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+// QueryNamespaceAbsenceProofResponse is the response type for the
+// NamespaceAbsenceProof gRPC method.
+type QueryNamespaceAbsenceProofResponse struct {
+	Proof *NamespaceAbsenceProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryNamespaceAbsenceProofResponse) Reset()         { *m = QueryNamespaceAbsenceProofResponse{} }
+func (m *QueryNamespaceAbsenceProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceAbsenceProofResponse) ProtoMessage()    {}
+func (*QueryNamespaceAbsenceProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e626addf1ae410d, []int{12}
+}
+func (m *QueryNamespaceAbsenceProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceAbsenceProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceAbsenceProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespaceAbsenceProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceAbsenceProofResponse.Merge(m, src)
+}
+func (m *QueryNamespaceAbsenceProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceAbsenceProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceAbsenceProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespaceAbsenceProofResponse proto.InternalMessageInfo
+
+func (m *QueryNamespaceAbsenceProofResponse) GetProof() *NamespaceAbsenceProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryBlobProofRequest)(nil), "celestia.core.v1.proof.QueryBlobProofRequest")
+	proto.RegisterType((*QueryBlobProofResponse)(nil), "celestia.core.v1.proof.QueryBlobProofResponse")
+	proto.RegisterType((*QueryTxInclusionProofRequest)(nil), "celestia.core.v1.proof.QueryTxInclusionProofRequest")
+	proto.RegisterType((*QueryTxInclusionProofResponse)(nil), "celestia.core.v1.proof.QueryTxInclusionProofResponse")
+	proto.RegisterType((*QueryShareInclusionProofRequest)(nil), "celestia.core.v1.proof.QueryShareInclusionProofRequest")
+	proto.RegisterType((*QueryShareInclusionProofResponse)(nil), "celestia.core.v1.proof.QueryShareInclusionProofResponse")
+	proto.RegisterType((*QueryRowInclusionProofRequest)(nil), "celestia.core.v1.proof.QueryRowInclusionProofRequest")
+	proto.RegisterType((*QueryRowInclusionProofResponse)(nil), "celestia.core.v1.proof.QueryRowInclusionProofResponse")
+	proto.RegisterType((*QueryMultiShareProofRequest)(nil), "celestia.core.v1.proof.QueryMultiShareProofRequest")
+	proto.RegisterType((*ShareRange)(nil), "celestia.core.v1.proof.ShareRange")
+	proto.RegisterType((*QueryMultiShareProofResponse)(nil), "celestia.core.v1.proof.QueryMultiShareProofResponse")
+	proto.RegisterType((*QueryNamespaceAbsenceProofRequest)(nil), "celestia.core.v1.proof.QueryNamespaceAbsenceProofRequest")
+	proto.RegisterType((*QueryNamespaceAbsenceProofResponse)(nil), "celestia.core.v1.proof.QueryNamespaceAbsenceProofResponse")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/proof/query.proto", fileDescriptor_0e626addf1ae410d)
+}
+
+var fileDescriptor_0e626addf1ae410d = []byte{
+	// 800 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0x4f, 0x6f, 0xd3, 0x48,
+	0x18, 0xc6, 0x3b, 0x89, 0x9a, 0x36, 0xef, 0xaa, 0xda, 0xee, 0xec, 0xb6, 0x9b, 0x75, 0xd3, 0x6c,
+	0xd6, 0x5a, 0x89, 0x82, 0x54, 0x9b, 0xfe, 0x2f, 0x85, 0x56, 0xd0, 0x22, 0xa0, 0x07, 0x10, 0x35,
+	0x1c, 0x00, 0x81, 0x22, 0x27, 0x19, 0x1c, 0x8b, 0xc4, 0xe3, 0xda, 0x4e, 0x13, 0x88, 0x72, 0x80,
+	0x4f, 0x00, 0xe2, 0x73, 0x20, 0x71, 0xe3, 0x23, 0xc0, 0xb1, 0x12, 0x12, 0xe2, 0x88, 0x5a, 0x3e,
+	0x08, 0xf2, 0xcc, 0xd4, 0x6d, 0x52, 0x4f, 0x9a, 0x70, 0xe0, 0x12, 0xd9, 0xe3, 0x79, 0xde, 0xf7,
+	0xf9, 0xbd, 0xf6, 0x3c, 0x0a, 0xa8, 0x25, 0x52, 0x25, 0x7e, 0x60, 0x9b, 0x7a, 0x89, 0x7a, 0x44,
+	0xdf, 0x9b, 0xd3, 0x5d, 0x8f, 0xd2, 0xa7, 0xfa, 0x6e, 0x9d, 0x78, 0xcf, 0x35, 0xd7, 0xa3, 0x01,
+	0xc5, 0x93, 0x47, 0x7b, 0xb4, 0x70, 0x8f, 0xb6, 0x37, 0xa7, 0xb1, 0x3d, 0x4a, 0xd6, 0xa2, 0xd4,
+	0xaa, 0x12, 0xdd, 0x74, 0x6d, 0xdd, 0x74, 0x1c, 0x1a, 0x98, 0x81, 0x4d, 0x1d, 0x9f, 0xab, 0x14,
+	0x59, 0x65, 0xf6, 0xcb, 0xf7, 0xa8, 0x16, 0x4c, 0xec, 0x84, 0x8d, 0x36, 0xab, 0xb4, 0x78, 0x37,
+	0x5c, 0x37, 0xc8, 0x6e, 0x9d, 0xf8, 0x01, 0x9e, 0x84, 0x54, 0x85, 0xd8, 0x56, 0x25, 0xc8, 0xa0,
+	0x3c, 0x9a, 0x49, 0x1a, 0xe2, 0x0e, 0xff, 0x0d, 0x23, 0x41, 0xb3, 0x50, 0x31, 0xfd, 0x4a, 0x26,
+	0x91, 0x47, 0x33, 0x69, 0x23, 0x15, 0x34, 0x6f, 0x99, 0x7e, 0x05, 0x4f, 0x03, 0x14, 0xab, 0xb4,
+	0x58, 0xb0, 0x9d, 0x32, 0x69, 0x66, 0x92, 0x79, 0x34, 0x33, 0x66, 0xa4, 0xc3, 0x95, 0xed, 0x70,
+	0x41, 0x7d, 0x01, 0x93, 0xdd, 0x8d, 0x7c, 0x97, 0x3a, 0x3e, 0xc1, 0x7f, 0xc1, 0xb0, 0x1f, 0x98,
+	0x1e, 0x6f, 0x34, 0x66, 0xf0, 0x1b, 0x3c, 0x0e, 0x49, 0xe2, 0x94, 0x59, 0x8f, 0x31, 0x23, 0xbc,
+	0xc4, 0xab, 0x30, 0xcc, 0x9c, 0xb3, 0xda, 0xbf, 0xcd, 0xab, 0x5a, 0xfc, 0x50, 0xb4, 0x7b, 0x15,
+	0xd3, 0x23, 0xbc, 0x05, 0x17, 0xa8, 0x3b, 0x90, 0x65, 0xbd, 0xef, 0x37, 0xb7, 0x9d, 0x52, 0xb5,
+	0xee, 0xdb, 0xd4, 0xe9, 0x8b, 0xf5, 0x1f, 0x18, 0x0d, 0x9a, 0x02, 0x88, 0x1b, 0x19, 0x09, 0x9a,
+	0x1c, 0xe7, 0x25, 0x82, 0x69, 0x49, 0xcd, 0x5f, 0x86, 0x65, 0xc2, 0xbf, 0xcc, 0x02, 0x7b, 0x32,
+	0x18, 0x59, 0x64, 0x2e, 0x11, 0x63, 0x2e, 0x19, 0x99, 0x53, 0x1f, 0x43, 0x5e, 0xde, 0x42, 0x80,
+	0x46, 0x00, 0x68, 0x50, 0x80, 0x9a, 0x98, 0xa1, 0x41, 0x1b, 0x83, 0xd9, 0x9f, 0x82, 0x34, 0x73,
+	0x5c, 0xf0, 0x68, 0x43, 0x20, 0x8c, 0xb2, 0x05, 0x83, 0x36, 0xc2, 0x2f, 0x94, 0x38, 0x65, 0xf6,
+	0x88, 0x93, 0xa4, 0x88, 0x53, 0x36, 0x68, 0x43, 0x7d, 0x00, 0x39, 0x59, 0x3b, 0x81, 0xb2, 0xdc,
+	0x89, 0x92, 0x97, 0xa1, 0x18, 0xb4, 0xd1, 0x01, 0xb2, 0x0b, 0x53, 0xac, 0xf2, 0xed, 0x7a, 0x35,
+	0xb0, 0x4f, 0x70, 0x9e, 0x81, 0xb1, 0x06, 0x29, 0xcf, 0x74, 0x2c, 0xe2, 0x67, 0x12, 0xf9, 0xe4,
+	0x99, 0xa3, 0x33, 0xc2, 0xad, 0x86, 0x50, 0xa8, 0x8b, 0x00, 0xc7, 0xab, 0xfd, 0x7e, 0x6c, 0xea,
+	0x13, 0xc8, 0xc6, 0x1b, 0x15, 0x03, 0x58, 0xef, 0x1c, 0xc0, 0x39, 0x99, 0xa1, 0x6e, 0xbd, 0x98,
+	0xc3, 0x43, 0xf8, 0x8f, 0x95, 0xbf, 0x63, 0xd6, 0x88, 0xef, 0x9a, 0x25, 0x72, 0xad, 0xe8, 0x13,
+	0xa7, 0xd4, 0xdf, 0x34, 0xb2, 0x90, 0x76, 0x8e, 0x74, 0x22, 0x5b, 0x8e, 0x17, 0x54, 0x1b, 0xd4,
+	0x5e, 0xa5, 0x85, 0xff, 0xad, 0x4e, 0xff, 0xb3, 0x32, 0xff, 0xf1, 0x55, 0xb8, 0x76, 0xfe, 0x4d,
+	0x1a, 0x86, 0x59, 0x2f, 0xfc, 0x1e, 0x41, 0x3a, 0x0a, 0x2c, 0x2c, 0xad, 0x16, 0x9b, 0xa0, 0x8a,
+	0xd6, 0xef, 0x76, 0xee, 0x5d, 0xbd, 0xfe, 0xea, 0xf3, 0xf7, 0xb7, 0x89, 0x0d, 0x7c, 0x45, 0x97,
+	0xe4, 0x76, 0x18, 0xa6, 0x7a, 0x8b, 0x4f, 0xab, 0xad, 0xb7, 0x44, 0x0c, 0xb7, 0xf5, 0xd6, 0x71,
+	0xee, 0xb6, 0xf1, 0x07, 0x04, 0xe3, 0xdd, 0x99, 0x84, 0x17, 0x7b, 0x5a, 0x91, 0xc4, 0xa2, 0xb2,
+	0x34, 0xa0, 0x4a, 0x70, 0x2c, 0x33, 0x8e, 0x8b, 0x58, 0x93, 0x71, 0x04, 0xcd, 0x4e, 0x0a, 0xe1,
+	0xfc, 0x23, 0x82, 0x3f, 0x63, 0x72, 0x06, 0xaf, 0xf4, 0xb4, 0x21, 0x0f, 0x3f, 0x65, 0x75, 0x70,
+	0xa1, 0x40, 0x58, 0x67, 0x08, 0x2b, 0x78, 0x49, 0x86, 0xe0, 0x87, 0x62, 0xff, 0x04, 0x06, 0x3b,
+	0x70, 0x6d, 0xbd, 0x45, 0x9c, 0x32, 0x23, 0xf9, 0xe3, 0x54, 0xc8, 0xe0, 0xde, 0xe3, 0x94, 0x65,
+	0xa0, 0xb2, 0x3c, 0xa8, 0x4c, 0x30, 0x6c, 0x31, 0x86, 0x75, 0x7c, 0x59, 0xc6, 0xe0, 0xd1, 0xc6,
+	0x29, 0x82, 0x30, 0x35, 0x39, 0x05, 0xbb, 0xc2, 0xef, 0x10, 0xfc, 0xde, 0x75, 0xd6, 0xf1, 0x42,
+	0x4f, 0x43, 0xf1, 0x11, 0xa8, 0x2c, 0x0e, 0x26, 0x12, 0x0c, 0x3a, 0x63, 0x38, 0xbf, 0x86, 0x2e,
+	0xa8, 0xff, 0xcb, 0x30, 0x6a, 0xa1, 0xb6, 0xc0, 0x5f, 0x08, 0xfe, 0x82, 0x60, 0x22, 0xf6, 0x6c,
+	0xe3, 0x4b, 0x3d, 0x0d, 0xf4, 0x0a, 0x2c, 0x65, 0xed, 0x67, 0xa4, 0x82, 0xe0, 0x06, 0x23, 0xb8,
+	0x8a, 0x37, 0x64, 0xf6, 0xa3, 0x84, 0x2b, 0x98, 0x5c, 0x7f, 0xe2, 0x95, 0x44, 0xcf, 0xda, 0x9b,
+	0x37, 0x3f, 0x1d, 0xe4, 0xd0, 0xfe, 0x41, 0x0e, 0x7d, 0x3b, 0xc8, 0xa1, 0xd7, 0x87, 0xb9, 0xa1,
+	0xfd, 0xc3, 0xdc, 0xd0, 0xd7, 0xc3, 0xdc, 0xd0, 0xa3, 0x59, 0xcb, 0x0e, 0x2a, 0xf5, 0xa2, 0x56,
+	0xa2, 0xb5, 0xa8, 0x07, 0xf5, 0xac, 0xe8, 0x7a, 0xd6, 0x74, 0x5d, 0xdd, 0x7d, 0x66, 0xf1, 0x7e,
+	0xc5, 0x14, 0xfb, 0xdf, 0xb7, 0xf0, 0x63, 0x00, 0x80, 0xad, 0xf6, 0x55, 0x77, 0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// BlobProof returns the share range of a blob of a MsgPayForBlobs
+	// transaction and the proof of inclusion of its shares to the data root.
+	BlobProof(ctx context.Context, in *QueryBlobProofRequest, opts ...grpc.CallOption) (*QueryBlobProofResponse, error)
+	// TxInclusionProof returns the share range of a transaction and the proof of
+	// inclusion of its shares to the data root.
+	TxInclusionProof(ctx context.Context, in *QueryTxInclusionProofRequest, opts ...grpc.CallOption) (*QueryTxInclusionProofResponse, error)
+	// ShareInclusionProof returns the proof of inclusion of a range of shares to
+	// the data root. The shares must belong to a single namespace.
+	ShareInclusionProof(ctx context.Context, in *QueryShareInclusionProofRequest, opts ...grpc.CallOption) (*QueryShareInclusionProofResponse, error)
+	// RowInclusionProof returns the proof of inclusion of a range of rows of the
+	// original data square to the data root.
+	RowInclusionProof(ctx context.Context, in *QueryRowInclusionProofRequest, opts ...grpc.CallOption) (*QueryRowInclusionProofResponse, error)
+	// MultiShareProof returns the proof of inclusion of several, possibly
+	// disjoint, ranges of shares to the data root. The shares of each range must
+	// belong to a single namespace.
+	MultiShareProof(ctx context.Context, in *QueryMultiShareProofRequest, opts ...grpc.CallOption) (*QueryMultiShareProofResponse, error)
+	// NamespaceAbsenceProof returns the proof that a namespace has no shares in
+	// the data square.
+	NamespaceAbsenceProof(ctx context.Context, in *QueryNamespaceAbsenceProofRequest, opts ...grpc.CallOption) (*QueryNamespaceAbsenceProofResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) BlobProof(ctx context.Context, in *QueryBlobProofRequest, opts ...grpc.CallOption) (*QueryBlobProofResponse, error) {
+	out := new(QueryBlobProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.proof.Query/BlobProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxInclusionProof(ctx context.Context, in *QueryTxInclusionProofRequest, opts ...grpc.CallOption) (*QueryTxInclusionProofResponse, error) {
+	out := new(QueryTxInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.proof.Query/TxInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ShareInclusionProof(ctx context.Context, in *QueryShareInclusionProofRequest, opts ...grpc.CallOption) (*QueryShareInclusionProofResponse, error) {
+	out := new(QueryShareInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.proof.Query/ShareInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RowInclusionProof(ctx context.Context, in *QueryRowInclusionProofRequest, opts ...grpc.CallOption) (*QueryRowInclusionProofResponse, error) {
+	out := new(QueryRowInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.proof.Query/RowInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MultiShareProof(ctx context.Context, in *QueryMultiShareProofRequest, opts ...grpc.CallOption) (*QueryMultiShareProofResponse, error) {
+	out := new(QueryMultiShareProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.proof.Query/MultiShareProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NamespaceAbsenceProof(ctx context.Context, in *QueryNamespaceAbsenceProofRequest, opts ...grpc.CallOption) (*QueryNamespaceAbsenceProofResponse, error) {
+	out := new(QueryNamespaceAbsenceProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.proof.Query/NamespaceAbsenceProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// BlobProof returns the share range of a blob of a MsgPayForBlobs
+	// transaction and the proof of inclusion of its shares to the data root.
+	BlobProof(context.Context, *QueryBlobProofRequest) (*QueryBlobProofResponse, error)
+	// TxInclusionProof returns the share range of a transaction and the proof of
+	// inclusion of its shares to the data root.
+	TxInclusionProof(context.Context, *QueryTxInclusionProofRequest) (*QueryTxInclusionProofResponse, error)
+	// ShareInclusionProof returns the proof of inclusion of a range of shares to
+	// the data root. The shares must belong to a single namespace.
+	ShareInclusionProof(context.Context, *QueryShareInclusionProofRequest) (*QueryShareInclusionProofResponse, error)
+	// RowInclusionProof returns the proof of inclusion of a range of rows of the
+	// original data square to the data root.
+	RowInclusionProof(context.Context, *QueryRowInclusionProofRequest) (*QueryRowInclusionProofResponse, error)
+	// MultiShareProof returns the proof of inclusion of several, possibly
+	// disjoint, ranges of shares to the data root. The shares of each range must
+	// belong to a single namespace.
+	MultiShareProof(context.Context, *QueryMultiShareProofRequest) (*QueryMultiShareProofResponse, error)
+	// NamespaceAbsenceProof returns the proof that a namespace has no shares in
+	// the data square.
+	NamespaceAbsenceProof(context.Context, *QueryNamespaceAbsenceProofRequest) (*QueryNamespaceAbsenceProofResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) BlobProof(ctx context.Context, req *QueryBlobProofRequest) (*QueryBlobProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobProof not implemented")
+}
+func (*UnimplementedQueryServer) TxInclusionProof(ctx context.Context, req *QueryTxInclusionProofRequest) (*QueryTxInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxInclusionProof not implemented")
+}
+func (*UnimplementedQueryServer) ShareInclusionProof(ctx context.Context, req *QueryShareInclusionProofRequest) (*QueryShareInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareInclusionProof not implemented")
+}
+func (*UnimplementedQueryServer) RowInclusionProof(ctx context.Context, req *QueryRowInclusionProofRequest) (*QueryRowInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RowInclusionProof not implemented")
+}
+func (*UnimplementedQueryServer) MultiShareProof(ctx context.Context, req *QueryMultiShareProofRequest) (*QueryMultiShareProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiShareProof not implemented")
+}
+func (*UnimplementedQueryServer) NamespaceAbsenceProof(ctx context.Context, req *QueryNamespaceAbsenceProofRequest) (*QueryNamespaceAbsenceProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceAbsenceProof not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_BlobProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlobProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlobProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.proof.Query/BlobProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlobProof(ctx, req.(*QueryBlobProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.proof.Query/TxInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxInclusionProof(ctx, req.(*QueryTxInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ShareInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShareInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ShareInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.proof.Query/ShareInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShareInclusionProof(ctx, req.(*QueryShareInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RowInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRowInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RowInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.proof.Query/RowInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RowInclusionProof(ctx, req.(*QueryRowInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MultiShareProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultiShareProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultiShareProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.proof.Query/MultiShareProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultiShareProof(ctx, req.(*QueryMultiShareProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NamespaceAbsenceProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamespaceAbsenceProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamespaceAbsenceProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.proof.Query/NamespaceAbsenceProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamespaceAbsenceProof(ctx, req.(*QueryNamespaceAbsenceProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.proof.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlobProof",
+			Handler:    _Query_BlobProof_Handler,
+		},
+		{
+			MethodName: "TxInclusionProof",
+			Handler:    _Query_TxInclusionProof_Handler,
+		},
+		{
+			MethodName: "ShareInclusionProof",
+			Handler:    _Query_ShareInclusionProof_Handler,
+		},
+		{
+			MethodName: "RowInclusionProof",
+			Handler:    _Query_RowInclusionProof_Handler,
+		},
+		{
+			MethodName: "MultiShareProof",
+			Handler:    _Query_MultiShareProof_Handler,
+		},
+		{
+			MethodName: "NamespaceAbsenceProof",
+			Handler:    _Query_NamespaceAbsenceProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/proof/query.proto",
+}
+
+func (m *QueryBlobProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlobIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlobIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.End != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.End != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShareInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShareInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShareInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Start != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShareInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShareInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShareInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRowInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRowInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRowInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndRow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndRow))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartRow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartRow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRowInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRowInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRowInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiShareProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiShareProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiShareProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ranges) > 0 {
+		for iNdEx := len(m.Ranges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ranges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShareRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShareRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiShareProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiShareProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiShareProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespaceAbsenceProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceAbsenceProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceAbsenceProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespaceAbsenceProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceAbsenceProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceAbsenceProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBlobProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlobIndex != 0 {
+		n += 1 + sovQuery(uint64(m.BlobIndex))
+	}
+	return n
+}
+
+func (m *QueryBlobProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovQuery(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovQuery(uint64(m.End))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *QueryTxInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovQuery(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovQuery(uint64(m.End))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryShareInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Start != 0 {
+		n += 1 + sovQuery(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovQuery(uint64(m.End))
+	}
+	return n
+}
+
+func (m *QueryShareInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRowInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.StartRow != 0 {
+		n += 1 + sovQuery(uint64(m.StartRow))
+	}
+	if m.EndRow != 0 {
+		n += 1 + sovQuery(uint64(m.EndRow))
+	}
+	return n
+}
+
+func (m *QueryRowInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMultiShareProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if len(m.Ranges) > 0 {
+		for _, e := range m.Ranges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShareRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovQuery(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovQuery(uint64(m.End))
+	}
+	return n
+}
+
+func (m *QueryMultiShareProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamespaceAbsenceProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamespaceAbsenceProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBlobProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobIndex", wireType)
+			}
+			m.BlobIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &ShareProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxInclusionProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxInclusionProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxInclusionProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxInclusionProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &ShareProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShareInclusionProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShareInclusionProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShareInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShareInclusionProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShareInclusionProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShareInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &ShareProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRowInclusionProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRowInclusionProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRowInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRow", wireType)
+			}
+			m.StartRow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartRow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndRow", wireType)
+			}
+			m.EndRow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndRow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRowInclusionProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRowInclusionProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRowInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &RowProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiShareProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiShareProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiShareProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ranges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ranges = append(m.Ranges, &ShareRange{})
+			if err := m.Ranges[len(m.Ranges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShareRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiShareProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiShareProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiShareProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &MultiShareProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamespaceAbsenceProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceAbsenceProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceAbsenceProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamespaceAbsenceProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceAbsenceProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceAbsenceProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &NamespaceAbsenceProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -4,9 +4,44 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/celestiaorg/rsmt2d"
 	"github.com/tendermint/tendermint/crypto/merkle"
 )
 
+// NewRowProof takes an extended data square and returns a proof of inclusion
+// of the rows [startRow, endRow] of the original data square to the data root.
+func NewRowProof(eds *rsmt2d.ExtendedDataSquare, startRow, endRow uint32) (RowProof, error) {
+	if endRow < startRow {
+		return RowProof{}, fmt.Errorf("end row %d must not be lower than the start row %d", endRow, startRow)
+	}
+	if squareSize := eds.Width() / 2; uint(endRow) >= squareSize {
+		return RowProof{}, fmt.Errorf("end row %d is not in the original data square of size %d", endRow, squareSize)
+	}
+
+	edsRowRoots, err := eds.RowRoots()
+	if err != nil {
+		return RowProof{}, err
+	}
+	edsColRoots, err := eds.ColRoots()
+	if err != nil {
+		return RowProof{}, err
+	}
+
+	// create the binary merkle inclusion proof for all the square rows to the data root
+	root, allProofs := merkle.ProofsFromByteSlices(append(edsRowRoots, edsColRoots...))
+	rowProof := RowProof{
+		RowRoots: edsRowRoots[startRow : endRow+1],
+		Proofs:   make([]*Proof, 0, endRow-startRow+1),
+		Root:     root,
+		StartRow: startRow,
+		EndRow:   endRow,
+	}
+	for row := startRow; row <= endRow; row++ {
+		rowProof.Proofs = append(rowProof.Proofs, ProofFromMerkle(*allProofs[row]))
+	}
+	return rowProof, nil
+}
+
 // Validate performs checks on the fields of this RowProof. Returns an error if
 // the proof fails validation. If the proof passes validation, this function
 // attempts to verify the proof. It returns nil if the proof is valid.
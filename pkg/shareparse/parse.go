@@ -0,0 +1,205 @@
+// Package shareparse reassembles the transactions and blobs of a sequence of
+// shares, e.g. a data square or a range of it, on top of the go-square share
+// parsing. Unlike the go-square parsers it doesn't stop at the first malformed
+// share: it reports every malformed share along with its index and byte offset
+// and keeps parsing the following sequences.
+package shareparse
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/celestiaorg/go-square/v2/share"
+)
+
+// Result is the content parsed from a sequence of shares.
+type Result struct {
+	// Txs are the transactions of the transaction namespace.
+	Txs [][]byte
+	// PFBs are the transactions of the pay for blob namespace.
+	PFBs [][]byte
+	// Blobs are the blobs, in the order of the shares.
+	Blobs []Blob
+	// PaddingShares is the number of padding shares.
+	PaddingShares int
+	// Errors are the malformed shares, in the order of the shares.
+	Errors []ShareError
+}
+
+// Blob is a blob reassembled from the shares [Start, End).
+type Blob struct {
+	Start int
+	End   int
+	Blob  *share.Blob
+}
+
+// ShareError describes a malformed share.
+type ShareError struct {
+	// Index is the index of the share in the parsed shares.
+	Index int
+	// Offset is the offset in bytes of the share in the parsed shares.
+	Offset int
+	Err    error
+}
+
+func (e ShareError) Error() string {
+	return fmt.Sprintf("share %d at offset %d: %v", e.Index, e.Offset, e.Err)
+}
+
+func (e ShareError) Unwrap() error {
+	return e.Err
+}
+
+// sequence is a sequence of shares being parsed.
+type sequence struct {
+	start     int
+	namespace share.Namespace
+	// needed is the number of shares of the sequence according to the
+	// sequence length of its first share.
+	needed int
+	shares []share.Share
+}
+
+// Parse parses the shares. The shares that are not valid, e.g. a continuation
+// share without a sequence start, are reported in the errors of the result
+// and skipped, as well as the sequences that can't be reassembled.
+func Parse(shares [][]byte) Result {
+	p := parser{}
+	for i, data := range shares {
+		p.push(i, data)
+	}
+	p.flush()
+	return p.result
+}
+
+// ParseReader parses the shares read from r, which is the concatenation of
+// the shares, until EOF. It only returns an error if r can't be read: a
+// truncated last share is reported in the errors of the result.
+func ParseReader(r io.Reader) (Result, error) {
+	p := parser{}
+	br := bufio.NewReader(r)
+	for i := 0; ; i++ {
+		data := make([]byte, share.ShareSize)
+		n, err := io.ReadFull(br, data)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return Result{}, err
+		}
+		p.push(i, data[:n])
+		if err != nil {
+			break
+		}
+	}
+	p.flush()
+	return p.result, nil
+}
+
+type parser struct {
+	result Result
+	// current is the sequence being parsed, if any.
+	current *sequence
+	// previous is the namespace of the previous valid share, if any.
+	previous *share.Namespace
+}
+
+func (p *parser) push(index int, data []byte) {
+	sh, err := share.NewShare(data)
+	if err != nil {
+		p.fail(index, err)
+		return
+	}
+	if err := sh.CheckVersionSupported(); err != nil {
+		p.fail(index, err)
+		return
+	}
+	namespace := sh.Namespace()
+	if p.previous != nil && namespace.IsLessThan(*p.previous) {
+		p.fail(index, fmt.Errorf("namespace %x is smaller than the namespace %x of the previous share", namespace.Bytes(), p.previous.Bytes()))
+		return
+	}
+	p.previous = &namespace
+
+	if sh.IsSequenceStart() {
+		p.flush()
+		if sh.IsPadding() {
+			p.result.PaddingShares++
+			return
+		}
+		needed := share.SparseSharesNeeded(sh.SequenceLen())
+		if sh.IsCompactShare() {
+			needed = share.CompactSharesNeeded(sh.SequenceLen())
+		}
+		p.current = &sequence{
+			start:     index,
+			namespace: namespace,
+			needed:    needed,
+		}
+	} else {
+		if sh.IsPadding() {
+			p.result.PaddingShares++
+			return
+		}
+		if p.current == nil {
+			p.fail(index, fmt.Errorf("continuation share of namespace %x without a sequence start", namespace.Bytes()))
+			return
+		}
+		if !namespace.Equals(p.current.namespace) {
+			p.fail(index, fmt.Errorf("continuation share of namespace %x in the sequence of namespace %x starting at share %d", namespace.Bytes(), p.current.namespace.Bytes(), p.current.start))
+			p.current = nil
+			return
+		}
+	}
+	p.current.shares = append(p.current.shares, *sh)
+	if len(p.current.shares) == p.current.needed {
+		p.complete(index + 1)
+	}
+}
+
+// flush reports the current sequence, if any, as incomplete.
+func (p *parser) flush() {
+	if p.current == nil {
+		return
+	}
+	p.fail(p.current.start, fmt.Errorf("sequence of namespace %x has %d shares instead of %d", p.current.namespace.Bytes(), len(p.current.shares), p.current.needed))
+	p.current = nil
+}
+
+// complete reassembles the current sequence, which ends at the share end.
+func (p *parser) complete(end int) {
+	seq := p.current
+	p.current = nil
+	switch {
+	case seq.namespace.IsTx() || seq.namespace.IsPayForBlob():
+		txs, err := share.ParseTxs(seq.shares)
+		if err != nil {
+			p.fail(seq.start, fmt.Errorf("parsing the transactions of namespace %x: %w", seq.namespace.Bytes(), err))
+			return
+		}
+		if seq.namespace.IsTx() {
+			p.result.Txs = append(p.result.Txs, txs...)
+		} else {
+			p.result.PFBs = append(p.result.PFBs, txs...)
+		}
+	default:
+		blobs, err := share.ParseBlobs(seq.shares)
+		if err != nil {
+			p.fail(seq.start, fmt.Errorf("parsing the blob of namespace %x: %w", seq.namespace.Bytes(), err))
+			return
+		}
+		for _, blob := range blobs {
+			p.result.Blobs = append(p.result.Blobs, Blob{Start: seq.start, End: end, Blob: blob})
+		}
+	}
+}
+
+func (p *parser) fail(index int, err error) {
+	p.result.Errors = append(p.result.Errors, ShareError{
+		Index:  index,
+		Offset: index * share.ShareSize,
+		Err:    err,
+	})
+}
@@ -0,0 +1,145 @@
+package shareparse_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/shareparse"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestParse(t *testing.T) {
+	shares, txs, blobs := newParseTestSquare(t)
+
+	result := shareparse.Parse(shares)
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, txs, result.Txs)
+	assert.Len(t, result.PFBs, 2)
+	assert.Greater(t, result.PaddingShares, 0)
+	require.Len(t, result.Blobs, len(blobs))
+	for i, blob := range result.Blobs {
+		assert.Equal(t, blobs[i].Namespace(), blob.Blob.Namespace())
+		assert.Equal(t, blobs[i].Data(), blob.Blob.Data())
+		assert.Equal(t, share.SparseSharesNeeded(uint32(len(blobs[i].Data()))), blob.End-blob.Start)
+		first, err := share.NewShare(shares[blob.Start])
+		require.NoError(t, err)
+		assert.True(t, first.IsSequenceStart())
+	}
+
+	fromReader, err := shareparse.ParseReader(bytes.NewReader(bytes.Join(shares, nil)))
+	require.NoError(t, err)
+	assert.Equal(t, result, fromReader)
+}
+
+func TestParseMalformed(t *testing.T) {
+	shares, _, _ := newParseTestSquare(t)
+	parsed := shareparse.Parse(shares)
+	// the first share of the first blob is a sequence start followed by a
+	// continuation share as the blob spans multiple shares.
+	blob := parsed.Blobs[0]
+	require.Greater(t, blob.End-blob.Start, 1)
+
+	tests := []struct {
+		name   string
+		modify func(shares [][]byte) [][]byte
+		// index is the index of the first malformed share
+		index int
+	}{
+		{
+			name: "missing sequence start",
+			modify: func(shares [][]byte) [][]byte {
+				return append(shares[:blob.Start:blob.Start], shares[blob.Start+1:]...)
+			},
+			index: blob.Start,
+		},
+		{
+			name: "missing continuation share",
+			modify: func(shares [][]byte) [][]byte {
+				return append(shares[:blob.Start+1:blob.Start+1], shares[blob.Start+2:]...)
+			},
+			index: blob.Start,
+		},
+		{
+			name: "unsupported share version",
+			modify: func(shares [][]byte) [][]byte {
+				shares[blob.Start][share.NamespaceSize] = 0xFE
+				return shares
+			},
+			index: blob.Start,
+		},
+		{
+			name: "namespace out of order",
+			modify: func(shares [][]byte) [][]byte {
+				copy(shares[blob.Start+1][:share.NamespaceSize], share.TxNamespace.Bytes())
+				return shares
+			},
+			index: blob.Start + 1,
+		},
+		{
+			name: "truncated share",
+			modify: func(shares [][]byte) [][]byte {
+				shares[blob.Start] = shares[blob.Start][:share.ShareSize-1]
+				return shares
+			},
+			index: blob.Start,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares, _, _ := newParseTestSquare(t)
+			result := shareparse.Parse(tt.modify(shares))
+			require.NotEmpty(t, result.Errors)
+			assert.Equal(t, tt.index, result.Errors[0].Index)
+			assert.Equal(t, tt.index*share.ShareSize, result.Errors[0].Offset)
+			// the other blobs are still reassembled
+			assert.Len(t, result.Blobs, len(parsed.Blobs)-1)
+		})
+	}
+}
+
+func TestParseReaderTruncated(t *testing.T) {
+	shares, _, _ := newParseTestSquare(t)
+	data := bytes.Join(shares, nil)
+
+	result, err := shareparse.ParseReader(bytes.NewReader(data[:len(data)-10]))
+	require.NoError(t, err)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, len(shares)-1, result.Errors[0].Index)
+	assert.Equal(t, (len(shares)-1)*share.ShareSize, result.Errors[0].Offset)
+}
+
+// newParseTestSquare returns the shares of a data square containing random
+// transactions and blob transactions, along with the transactions and the
+// blobs in the order of the square.
+func newParseTestSquare(t *testing.T) ([][]byte, [][]byte, []*share.Blob) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	namespaces := []share.Namespace{
+		share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize)),
+		share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize)),
+	}
+	blobTxs := tmtypes.Txs(blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, namespaces, []int{5000, 2000})).ToSliceOfBytes()
+	txs := testfactory.GenerateRandomTxs(20, 500).ToSliceOfBytes()
+
+	dataSquare, err := square.Construct(append(txs, blobTxs...), appconsts.DefaultGovMaxSquareSize, appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+
+	var blobs []*share.Blob
+	for _, rawTx := range blobTxs {
+		blobTx, isBlobTx, err := tx.UnmarshalBlobTx(rawTx)
+		require.NoError(t, err)
+		require.True(t, isBlobTx)
+		blobs = append(blobs, blobTx.Blobs...)
+	}
+	share.SortBlobs(blobs)
+	return share.ToBytes(dataSquare), txs, blobs
+}
@@ -0,0 +1,248 @@
+// Package compression defines an opt-in framing to compress the data of blobs
+// so that blob producers and consumers share one format. A compressed blob
+// data starts with a header describing how to decompress it:
+//
+//	| magic (4 bytes) | version (1 byte) | algorithm (1 byte) | decompressed size (uvarint) | compressed data |
+//
+// The framing doesn't depend on the rest of pkg/user so that it can be used by
+// consumers without pulling in the transaction client.
+package compression
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Algorithm is a compression algorithm.
+type Algorithm uint8
+
+const (
+	// None means that the data is not compressed.
+	None Algorithm = iota
+	Zstd
+	Snappy
+)
+
+const (
+	// Version is the version of the header.
+	Version uint8 = 1
+
+	// MaxDecompressedSize is the max decompressed size of a compressed data
+	// to protect the consumers from decompression bombs. It is larger than
+	// the largest blob that fits in a square.
+	MaxDecompressedSize = 256 << 20
+)
+
+const (
+	// minZstdDecoderMemory is the floor of the max memory of the zstd decoder.
+	minZstdDecoderMemory = 1 << 20
+
+	// maxSnappyRatio is the max ratio between the decoded and the encoded size
+	// of snappy data: every element of the stream takes at least 2 bytes and
+	// decodes to at most 64 bytes.
+	maxSnappyRatio = 32
+)
+
+// Magic is the prefix of compressed data.
+var Magic = []byte{'C', 'B', 'L', 'Z'}
+
+var (
+	zstdEncoderOnce sync.Once
+	zstdEncoder     *zstd.Encoder
+	zstdEncoderErr  error
+)
+
+// Algorithms returns the names of the supported compression algorithms.
+func Algorithms() []string {
+	return []string{Zstd.String(), Snappy.String()}
+}
+
+// ParseAlgorithm returns the algorithm with the given name.
+func ParseAlgorithm(name string) (Algorithm, error) {
+	switch strings.ToLower(name) {
+	case Zstd.String():
+		return Zstd, nil
+	case Snappy.String():
+		return Snappy, nil
+	default:
+		return None, fmt.Errorf("unknown compression algorithm %q, must be one of %s", name, strings.Join(Algorithms(), ", "))
+	}
+}
+
+func (a Algorithm) String() string {
+	switch a {
+	case None:
+		return "none"
+	case Zstd:
+		return "zstd"
+	case Snappy:
+		return "snappy"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(a))
+	}
+}
+
+// Header is the header of compressed data.
+type Header struct {
+	Version   uint8
+	Algorithm Algorithm
+	// Size is the size of the decompressed data.
+	Size uint64
+}
+
+// Compress compresses the data with the algorithm and prepends the header.
+func Compress(data []byte, algorithm Algorithm) ([]byte, error) {
+	out := make([]byte, 0, MaxCompressedSize(len(data), algorithm))
+	out = append(out, Magic...)
+	out = append(out, Version, byte(algorithm))
+	out = binary.AppendUvarint(out, uint64(len(data)))
+	switch algorithm {
+	case Zstd:
+		encoder, err := newZstdEncoder()
+		if err != nil {
+			return nil, err
+		}
+		return encoder.EncodeAll(data, out), nil
+	case Snappy:
+		return append(out, snappy.Encode(nil, data)...), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression algorithm %s", algorithm)
+	}
+}
+
+// MaxCompressedSize returns the max size of n bytes of data compressed with the
+// algorithm, including the header.
+func MaxCompressedSize(n int, algorithm Algorithm) int {
+	size := len(Magic) + 2 + binary.MaxVarintLen64
+	switch algorithm {
+	case Zstd:
+		// ZSTD_COMPRESSBOUND
+		size += n + n>>8
+		if n < 128<<10 {
+			size += (128<<10 - n) >> 11
+		}
+	case Snappy:
+		size += snappy.MaxEncodedLen(n)
+	default:
+		size += n
+	}
+	return size
+}
+
+// IsCompressed returns true if the data starts with the magic of compressed
+// data.
+func IsCompressed(data []byte) bool {
+	return bytes.HasPrefix(data, Magic)
+}
+
+// DecodeHeader decodes the header of compressed data and returns it along with
+// the compressed data following it.
+func DecodeHeader(data []byte) (Header, []byte, error) {
+	if !IsCompressed(data) {
+		return Header{}, nil, errors.New("the data is not compressed: missing magic")
+	}
+	data = data[len(Magic):]
+	if len(data) < 2 {
+		return Header{}, nil, errors.New("truncated header")
+	}
+	header := Header{Version: data[0], Algorithm: Algorithm(data[1])}
+	if header.Version != Version {
+		return Header{}, nil, fmt.Errorf("unsupported header version %d", header.Version)
+	}
+	size, n := binary.Uvarint(data[2:])
+	if n <= 0 {
+		return Header{}, nil, errors.New("invalid decompressed size")
+	}
+	if size > MaxDecompressedSize {
+		return Header{}, nil, fmt.Errorf("decompressed size %d exceeds the max %d", size, MaxDecompressedSize)
+	}
+	header.Size = size
+	return header, data[2+n:], nil
+}
+
+// Decompress decompresses data compressed with Compress.
+func Decompress(data []byte) ([]byte, error) {
+	header, compressed, err := DecodeHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	var decompressed []byte
+	switch header.Algorithm {
+	case Zstd:
+		// the window of a frame can be larger than its content, e.g. for small
+		// data, so the memory is only limited to the decompressed size above
+		// a floor.
+		decoder, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(max(header.Size, minZstdDecoderMemory)))
+		if err != nil {
+			return nil, err
+		}
+		defer decoder.Close()
+		// the header can't be trusted so the buffer is not allocated from its
+		// size but grown by the decoder within its max memory.
+		decompressed, err = decoder.DecodeAll(compressed, nil)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing zstd data: %w", err)
+		}
+	case Snappy:
+		n, err := snappy.DecodedLen(compressed)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing snappy data: %w", err)
+		}
+		if uint64(n) != header.Size {
+			return nil, fmt.Errorf("the decompressed size %d doesn't match the size %d of the header", n, header.Size)
+		}
+		// snappy allocates the decoded size of the stream up front so it is
+		// checked against the size of the compressed data first.
+		if n > maxSnappyRatio*len(compressed) {
+			return nil, fmt.Errorf("the decompressed size %d is too large for %d bytes of snappy data", n, len(compressed))
+		}
+		decompressed, err = snappy.Decode(nil, compressed)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing snappy data: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported compression algorithm %s", header.Algorithm)
+	}
+	if uint64(len(decompressed)) != header.Size {
+		return nil, fmt.Errorf("the decompressed size %d doesn't match the size %d of the header", len(decompressed), header.Size)
+	}
+	return decompressed, nil
+}
+
+// CompressBlob returns a blob with the same namespace, share version and
+// signer as the blob and its data compressed with the algorithm.
+func CompressBlob(blob *share.Blob, algorithm Algorithm) (*share.Blob, error) {
+	data, err := Compress(blob.Data(), algorithm)
+	if err != nil {
+		return nil, err
+	}
+	return share.NewBlob(blob.Namespace(), data, blob.ShareVersion(), blob.Signer())
+}
+
+// DecompressBlob returns the decompressed data of a blob created with
+// CompressBlob. The data of a blob that doesn't start with a valid header, even
+// if it starts with the magic, is not compressed and is returned as is. An
+// error is returned if the header is valid but the data can't be decompressed.
+func DecompressBlob(blob *share.Blob) ([]byte, error) {
+	if _, _, err := DecodeHeader(blob.Data()); err != nil {
+		return blob.Data(), nil
+	}
+	return Decompress(blob.Data())
+}
+
+// newZstdEncoder returns the shared zstd encoder, which is safe for concurrent
+// use with EncodeAll.
+func newZstdEncoder() (*zstd.Encoder, error) {
+	zstdEncoderOnce.Do(func() {
+		zstdEncoder, zstdEncoderErr = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	})
+	return zstdEncoder, zstdEncoderErr
+}
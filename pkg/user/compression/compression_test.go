@@ -0,0 +1,184 @@
+package compression_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"runtime"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/user/compression"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestCompress(t *testing.T) {
+	compressible := bytes.Repeat([]byte("celestia"), 1000)
+	random := tmrand.Bytes(10000)
+	small := tmrand.Bytes(900)
+
+	for _, algorithm := range []compression.Algorithm{compression.Zstd, compression.Snappy} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			for _, data := range [][]byte{compressible, random, small, {}} {
+				compressed, err := compression.Compress(data, algorithm)
+				require.NoError(t, err)
+				assert.True(t, compression.IsCompressed(compressed))
+				assert.LessOrEqual(t, len(compressed), compression.MaxCompressedSize(len(data), algorithm))
+
+				header, _, err := compression.DecodeHeader(compressed)
+				require.NoError(t, err)
+				assert.Equal(t, compression.Header{Version: compression.Version, Algorithm: algorithm, Size: uint64(len(data))}, header)
+
+				decompressed, err := compression.Decompress(compressed)
+				require.NoError(t, err)
+				assert.Equal(t, len(data), len(decompressed))
+				assert.True(t, bytes.Equal(data, decompressed))
+			}
+
+			compressed, err := compression.Compress(compressible, algorithm)
+			require.NoError(t, err)
+			assert.Less(t, len(compressed), len(compressible)/10)
+
+			parsed, err := compression.ParseAlgorithm(algorithm.String())
+			require.NoError(t, err)
+			assert.Equal(t, algorithm, parsed)
+		})
+	}
+
+	_, err := compression.Compress(compressible, compression.None)
+	assert.Error(t, err)
+	_, err = compression.ParseAlgorithm("gzip")
+	assert.Error(t, err)
+}
+
+func TestDecompressInvalid(t *testing.T) {
+	data := bytes.Repeat([]byte("celestia"), 100)
+	compressed, err := compression.Compress(data, compression.Zstd)
+	require.NoError(t, err)
+	headerSize := len(compression.Magic) + 2 + 2 // the size of data takes 2 bytes as a uvarint
+
+	tests := []struct {
+		name   string
+		modify func(b []byte) []byte
+	}{
+		{
+			name:   "not compressed",
+			modify: func([]byte) []byte { return data },
+		},
+		{
+			name:   "truncated header",
+			modify: func(b []byte) []byte { return b[:len(compression.Magic)+1] },
+		},
+		{
+			name: "unsupported version",
+			modify: func(b []byte) []byte {
+				b[len(compression.Magic)] = compression.Version + 1
+				return b
+			},
+		},
+		{
+			name: "unknown algorithm",
+			modify: func(b []byte) []byte {
+				b[len(compression.Magic)+1] = 0xFF
+				return b
+			},
+		},
+		{
+			name: "other size",
+			modify: func(b []byte) []byte {
+				binary.PutUvarint(b[len(compression.Magic)+2:], uint64(len(data)+1))
+				return b
+			},
+		},
+		{
+			name: "size too large",
+			modify: func(b []byte) []byte {
+				header := append([]byte{}, b[:len(compression.Magic)+2]...)
+				header = binary.AppendUvarint(header, compression.MaxDecompressedSize+1)
+				return append(header, b[headerSize:]...)
+			},
+		},
+		{
+			name:   "truncated data",
+			modify: func(b []byte) []byte { return b[:len(b)-1] },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := compression.Decompress(tt.modify(append([]byte{}, compressed...)))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCompressBlob(t *testing.T) {
+	data := bytes.Repeat([]byte("celestia"), 1000)
+	signer := tmrand.Bytes(share.SignerSize)
+	blob, err := share.NewV1Blob(share.RandomBlobNamespace(), data, signer)
+	require.NoError(t, err)
+
+	compressed, err := compression.CompressBlob(blob, compression.Snappy)
+	require.NoError(t, err)
+	assert.Equal(t, blob.Namespace(), compressed.Namespace())
+	assert.Equal(t, blob.ShareVersion(), compressed.ShareVersion())
+	assert.Equal(t, signer, compressed.Signer())
+	assert.Less(t, compressed.DataLen(), blob.DataLen())
+
+	decompressed, err := compression.DecompressBlob(compressed)
+	require.NoError(t, err)
+	assert.Equal(t, data, decompressed)
+
+	// the data of a blob that is not compressed is returned as is
+	decompressed, err = compression.DecompressBlob(blob)
+	require.NoError(t, err)
+	assert.Equal(t, data, decompressed)
+}
+
+func TestDecompressBlobNotCompressed(t *testing.T) {
+	namespace := share.RandomBlobNamespace()
+	for _, data := range [][]byte{
+		[]byte("CBLZ is the magic of compressed blob data"),
+		append(append([]byte{}, compression.Magic...), compression.Version, 0xFF),
+		compression.Magic,
+	} {
+		blob, err := share.NewV0Blob(namespace, data)
+		require.NoError(t, err)
+		decompressed, err := compression.DecompressBlob(blob)
+		require.NoError(t, err)
+		assert.Equal(t, data, decompressed)
+	}
+
+	// the data of a blob with a valid header that can't be decompressed is
+	// an error.
+	compressed, err := compression.Compress(bytes.Repeat([]byte("celestia"), 100), compression.Zstd)
+	require.NoError(t, err)
+	blob, err := share.NewV0Blob(namespace, compressed[:len(compressed)-1])
+	require.NoError(t, err)
+	_, err = compression.DecompressBlob(blob)
+	assert.Error(t, err)
+}
+
+func TestDecompressSizeFromHeader(t *testing.T) {
+	// a few bytes of data claiming the max decompressed size in their header
+	// must not allocate it.
+	for _, algorithm := range []compression.Algorithm{compression.Zstd, compression.Snappy} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			data := append([]byte{}, compression.Magic...)
+			data = append(data, compression.Version, byte(algorithm))
+			data = binary.AppendUvarint(data, compression.MaxDecompressedSize)
+			switch algorithm {
+			case compression.Snappy:
+				data = binary.AppendUvarint(data, compression.MaxDecompressedSize)
+			case compression.Zstd:
+				data = append(data, 0x28, 0xb5, 0x2f, 0xfd)
+			}
+			var before, after runtime.MemStats
+			runtime.ReadMemStats(&before)
+			_, err := compression.Decompress(data)
+			runtime.ReadMemStats(&after)
+			assert.Error(t, err)
+			assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(compression.MaxDecompressedSize/4))
+		})
+	}
+}
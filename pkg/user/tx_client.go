@@ -30,6 +30,7 @@ import (
 	apperrors "github.com/celestiaorg/celestia-app/v3/app/errors"
 	"github.com/celestiaorg/celestia-app/v3/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user/compression"
 	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
 	"github.com/celestiaorg/celestia-app/v3/x/minfee"
 )
@@ -120,6 +121,16 @@ func WithDefaultAccount(name string) Option {
 	}
 }
 
+// WithBlobCompression compresses the data of the blobs paid for by the client
+// with the algorithm, using the framing of the compression package, so that
+// fewer bytes are paid for. Consumers decompress the blobs with
+// compression.DecompressBlob.
+func WithBlobCompression(algorithm compression.Algorithm) Option {
+	return func(c *TxClient) {
+		c.blobCompression = algorithm
+	}
+}
+
 // TxClient is an abstraction for building, signing, and broadcasting Celestia transactions
 // It supports multiple accounts. If none is specified, it will
 // try use the default account.
@@ -137,6 +148,8 @@ type TxClient struct {
 	defaultGasPrice float64
 	defaultAccount  string
 	defaultAddress  sdktypes.AccAddress
+	// blobCompression is the algorithm used to compress the data of the blobs
+	blobCompression compression.Algorithm
 }
 
 // NewTxClient returns a new signer using the provided keyring
@@ -270,6 +283,17 @@ func (client *TxClient) BroadcastPayForBlobWithAccount(ctx context.Context, acco
 		return nil, err
 	}
 
+	if client.blobCompression != compression.None {
+		compressed := make([]*share.Blob, len(blobs))
+		for i, blob := range blobs {
+			var err error
+			if compressed[i], err = compression.CompressBlob(blob, client.blobCompression); err != nil {
+				return nil, err
+			}
+		}
+		blobs = compressed
+	}
+
 	blobSizes := make([]uint32, len(blobs))
 	for i, blob := range blobs {
 		blobSizes[i] = uint32(len(blob.Data()))
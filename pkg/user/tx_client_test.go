@@ -1,6 +1,7 @@
 package user_test
 
 import (
+	"bytes"
 	"context"
 	"testing"
 	"time"
@@ -18,8 +19,11 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/app/encoding"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v3/pkg/user"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user/compression"
 	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
 )
 
 func TestTxClientTestSuite(t *testing.T) {
@@ -41,7 +45,7 @@ type TxClientTestSuite struct {
 func (suite *TxClientTestSuite) SetupSuite() {
 	suite.encCfg = encoding.MakeConfig(app.ModuleEncodingRegisters...)
 	config := testnode.DefaultConfig().
-		WithFundedAccounts("a", "b", "c").
+		WithFundedAccounts("a", "b", "c", "d").
 		WithAppCreator(testnode.CustomAppCreator("0utia"))
 	suite.ctx, _, _ = testnode.NewNetwork(suite.T(), config)
 	_, err := suite.ctx.WaitForHeight(1)
@@ -87,6 +91,34 @@ func (suite *TxClientTestSuite) TestSubmitPayForBlob() {
 		require.EqualValues(t, getTxResp.TxResponse.GasWanted, 1e6)
 	})
 
+	t.Run("submit compressed blob", func(t *testing.T) {
+		txClient, err := user.SetupTxClient(subCtx, suite.ctx.Keyring, suite.ctx.GRPCClient, suite.encCfg,
+			user.WithDefaultAccount("d"), user.WithBlobCompression(compression.Zstd))
+		require.NoError(t, err)
+		data := bytes.Repeat([]byte("celestia"), 1000)
+		blob, err := share.NewV0Blob(share.RandomBlobNamespace(), data)
+		require.NoError(t, err)
+		resp, err := txClient.SubmitPayForBlob(subCtx, []*share.Blob{blob})
+		require.NoError(t, err)
+		require.EqualValues(t, 0, resp.Code)
+
+		res, err := suite.ctx.Client.Block(subCtx, &resp.Height)
+		require.NoError(t, err)
+		var found bool
+		for _, rawTx := range res.Block.Txs {
+			blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
+			if err != nil || !isBlobTx || !blobTx.Blobs[0].Namespace().Equals(blob.Namespace()) {
+				continue
+			}
+			found = true
+			require.Less(t, blobTx.Blobs[0].DataLen(), len(data))
+			decompressed, err := compression.DecompressBlob(blobTx.Blobs[0])
+			require.NoError(t, err)
+			require.Equal(t, data, decompressed)
+		}
+		require.True(t, found)
+	})
+
 	t.Run("try submit a blob with an account that doesn't exist", func(t *testing.T) {
 		_, err := suite.txClient.SubmitPayForBlobWithAccount(subCtx, "non-existent account", blobs)
 		require.Error(t, err)
@@ -0,0 +1,103 @@
+package wrapper
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+
+	"github.com/celestiaorg/nmt"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
+)
+
+const (
+	// HashFuncSHA256 is the name of the sha256 base hash function. It is the
+	// one used by consensus.
+	HashFuncSHA256 = "sha256"
+	// HashFuncMiMC is the name of the MiMC base hash function over the scalar
+	// field of the BN254 curve, which is cheaper to verify in zk circuits.
+	HashFuncMiMC = "mimc-bn254"
+)
+
+// HashFuncs returns the names of the supported base hash functions.
+func HashFuncs() []string {
+	return []string{HashFuncSHA256, HashFuncMiMC}
+}
+
+// NewHashFunc returns the constructor of the supported base hash function with
+// the given name.
+func NewHashFunc(name string) (func() hash.Hash, error) {
+	switch name {
+	case HashFuncSHA256:
+		return sha256.New, nil
+	case HashFuncMiMC:
+		return newMiMCHash, nil
+	default:
+		return nil, fmt.Errorf("unsupported hash function %q: must be one of %v", name, HashFuncs())
+	}
+}
+
+// WithHashFunc returns an option replacing the base hash function of the NMTs,
+// sha256 by default, with the ones returned by newHash. It is meant to compute
+// alternative commitments to a square off-chain, e.g. for zk light clients:
+// the data roots computed with another hash function are not valid for
+// consensus.
+func WithHashFunc(newHash func() hash.Hash) nmt.Option {
+	return func(opts *nmt.Options) {
+		// the option is applied once more after the default hasher is created
+		// so the namespace size and the ignore max namespace flag are set.
+		opts.Hasher = nmt.NewNmtHasher(newHash(), opts.NamespaceIDSize, opts.IgnoreMaxNamespace)
+	}
+}
+
+// mimcChunkSize is the number of input bytes packed in each field element.
+// It is lower than the size of an element so they are always canonical.
+const mimcChunkSize = mimc.BlockSize - 1
+
+// mimcHash adapts the MiMC hash function, which only accepts canonical field
+// elements, to arbitrary inputs. The input is split into chunks of
+// mimcChunkSize bytes, each one left-padded to an element, followed by an
+// element encoding the length of the input so that the padding is not
+// ambiguous.
+type mimcHash struct {
+	data []byte
+}
+
+var _ hash.Hash = &mimcHash{}
+
+func newMiMCHash() hash.Hash {
+	return &mimcHash{}
+}
+
+func (h *mimcHash) Write(p []byte) (int, error) {
+	h.data = append(h.data, p...)
+	return len(p), nil
+}
+
+func (h *mimcHash) Sum(b []byte) []byte {
+	hasher := mimc.NewMiMC()
+	element := make([]byte, mimc.BlockSize)
+	for start := 0; start < len(h.data); start += mimcChunkSize {
+		end := min(start+mimcChunkSize, len(h.data))
+		clear(element)
+		copy(element[mimc.BlockSize-(end-start):], h.data[start:end])
+		// the elements are canonical so writing them can't fail.
+		_, _ = hasher.Write(element)
+	}
+	clear(element)
+	binary.BigEndian.PutUint64(element[mimc.BlockSize-8:], uint64(len(h.data)))
+	_, _ = hasher.Write(element)
+	return hasher.Sum(b)
+}
+
+func (h *mimcHash) Reset() {
+	h.data = h.data[:0]
+}
+
+func (h *mimcHash) Size() int {
+	return mimc.BlockSize
+}
+
+func (h *mimcHash) BlockSize() int {
+	return mimcChunkSize
+}
@@ -0,0 +1,90 @@
+package wrapper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHashFunc(t *testing.T) {
+	testCases := []struct {
+		name          string
+		emptyHash     string
+		celestiaHash  string
+		expectedError bool
+	}{
+		{
+			name:         wrapper.HashFuncSHA256,
+			emptyHash:    "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			celestiaHash: "13569e544705849042059c1e3092cd39a934f4501f3078b0218a8e688cbfd0f9",
+		},
+		{
+			name:         wrapper.HashFuncMiMC,
+			emptyHash:    "2c7298fd87d3039ffea208538f6b297b60b373a63792b4cd0654fdc88fd0d6ee",
+			celestiaHash: "0ac7f25cbd33c4029074d5b65a994f6eb09e7159ed258954a842c2d40ca664ed",
+		},
+		{
+			name:          "unknown",
+			expectedError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			newHash, err := wrapper.NewHashFunc(tc.name)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			h := newHash()
+			assert.Equal(t, tc.emptyHash, hex.EncodeToString(h.Sum(nil)))
+
+			// the hash doesn't depend on how the input is split across writes
+			_, err = h.Write([]byte("cel"))
+			require.NoError(t, err)
+			_, err = h.Write([]byte("estia"))
+			require.NoError(t, err)
+			assert.Equal(t, tc.celestiaHash, hex.EncodeToString(h.Sum(nil)))
+
+			h.Reset()
+			assert.Equal(t, tc.emptyHash, hex.EncodeToString(h.Sum(nil)))
+		})
+	}
+}
+
+func TestWithHashFunc(t *testing.T) {
+	squareSize := 8
+	data := generateErasuredData(t, squareSize, appconsts.DefaultCodec())
+	root := func(tree wrapper.ErasuredNamespacedMerkleTree) []byte {
+		for _, d := range data {
+			require.NoError(t, tree.Push(d))
+		}
+		root, err := tree.Root()
+		require.NoError(t, err)
+		return root
+	}
+	defaultRoot := root(wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), 0))
+
+	sha256Hash, err := wrapper.NewHashFunc(wrapper.HashFuncSHA256)
+	require.NoError(t, err)
+	assert.Equal(t, defaultRoot, root(wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), 0, wrapper.WithHashFunc(sha256Hash))))
+
+	mimcHash, err := wrapper.NewHashFunc(wrapper.HashFuncMiMC)
+	require.NoError(t, err)
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), 0, wrapper.WithHashFunc(mimcHash))
+	mimcRoot := root(tree)
+	assert.NotEqual(t, defaultRoot, mimcRoot)
+
+	// the proofs of the tree verify using the same hash function
+	proof, err := tree.ProveRange(0, 1)
+	require.NoError(t, err)
+	namespaceID := data[0][:share.NamespaceSize]
+	assert.True(t, proof.VerifyInclusion(mimcHash(), namespaceID, [][]byte{data[0]}, mimcRoot))
+	assert.False(t, proof.VerifyInclusion(sha256Hash(), namespaceID, [][]byte{data[0]}, mimcRoot))
+}
@@ -46,6 +46,7 @@ type Tree interface {
 	Root() ([]byte, error)
 	Push(namespacedData namespace.PrefixedData) error
 	ProveRange(start, end int) (nmt.Proof, error)
+	ProveNamespace(nID namespace.ID) (nmt.Proof, error)
 }
 
 // NewErasuredNamespacedMerkleTree creates a new ErasuredNamespacedMerkleTree
@@ -62,6 +63,33 @@ func NewErasuredNamespacedMerkleTree(squareSize uint64, axisIndex uint, options
 	return ErasuredNamespacedMerkleTree{squareSize: squareSize, options: options, tree: tree, axisIndex: uint64(axisIndex), shareIndex: 0}
 }
 
+// NewAxisTree returns the ErasuredNamespacedMerkleTree of a row or column of
+// an extended data square with all of its shares pushed. axisIndex is the index
+// of the row or column. The trees used by rsmt2d to compute the roots of the
+// square are not accessible so the tree has to be re-created to prove shares.
+func NewAxisTree(shares [][]byte, axisIndex uint) (*ErasuredNamespacedMerkleTree, error) {
+	if len(shares) == 0 || len(shares)%2 != 0 {
+		return nil, fmt.Errorf("an axis of an extended data square must have an even and non zero number of shares, got %d", len(shares))
+	}
+	tree := NewErasuredNamespacedMerkleTree(uint64(len(shares)/2), axisIndex)
+	for _, sh := range shares {
+		if err := tree.Push(sh); err != nil {
+			return nil, err
+		}
+	}
+	return &tree, nil
+}
+
+// NewEDSAxisTree returns the ErasuredNamespacedMerkleTree of the row or column
+// at index of the extended data square with all of its shares pushed.
+func NewEDSAxisTree(eds *rsmt2d.ExtendedDataSquare, axis rsmt2d.Axis, index uint) (*ErasuredNamespacedMerkleTree, error) {
+	shares := eds.Row(index)
+	if axis == rsmt2d.Col {
+		shares = eds.Col(index)
+	}
+	return NewAxisTree(shares, index)
+}
+
 type constructor struct {
 	squareSize uint64
 	opts       []nmt.Option
@@ -128,6 +156,13 @@ func (w *ErasuredNamespacedMerkleTree) ProveRange(start, end int) (nmt.Proof, er
 	return w.tree.ProveRange(start, end)
 }
 
+// ProveNamespace returns a Merkle range proof for the leaves of the namespace
+// nID. If the namespace is absent but within the range of the tree, the proof
+// is a proof of absence.
+func (w *ErasuredNamespacedMerkleTree) ProveNamespace(nID namespace.ID) (nmt.Proof, error) {
+	return w.tree.ProveNamespace(nID)
+}
+
 // incrementShareIndex increments the share index by one.
 func (w *ErasuredNamespacedMerkleTree) incrementShareIndex() {
 	w.shareIndex++
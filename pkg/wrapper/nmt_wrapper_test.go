@@ -14,6 +14,7 @@ import (
 	nmtnamespace "github.com/celestiaorg/nmt/namespace"
 	"github.com/celestiaorg/rsmt2d"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPushErasuredNamespacedMerkleTree(t *testing.T) {
@@ -136,6 +137,32 @@ func TestComputeExtendedDataSquare(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestNewEDSAxisTree(t *testing.T) {
+	squareSize := 4
+	data := testfactory.GenerateRandNamespacedRawData(squareSize * squareSize)
+	eds, err := rsmt2d.ComputeExtendedDataSquare(data, appconsts.DefaultCodec(), wrapper.NewConstructor(uint64(squareSize)))
+	require.NoError(t, err)
+	rowRoots, err := eds.RowRoots()
+	require.NoError(t, err)
+	colRoots, err := eds.ColRoots()
+	require.NoError(t, err)
+
+	for i := uint(0); i < eds.Width(); i++ {
+		for axis, roots := range map[rsmt2d.Axis][][]byte{rsmt2d.Row: rowRoots, rsmt2d.Col: colRoots} {
+			tree, err := wrapper.NewEDSAxisTree(eds, axis, i)
+			require.NoError(t, err)
+			root, err := tree.Root()
+			require.NoError(t, err)
+			assert.Equal(t, roots[i], root)
+		}
+	}
+
+	_, err = wrapper.NewAxisTree(eds.Row(0)[:3], 0)
+	assert.Error(t, err)
+	_, err = wrapper.NewAxisTree(nil, 0)
+	assert.Error(t, err)
+}
+
 // generateErasuredData generates random data and then erasure codes it. It
 // returns a slice that is twice as long as numLeaves because it returns the
 // original data + erasured data.
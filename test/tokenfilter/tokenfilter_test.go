@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/x/tokenfilter"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
@@ -132,6 +133,43 @@ func (suite *TokenFilterTestSuite) TestHandleInboundTransfer() {
 	suite.Require().Equal(emptyCoin, balance)
 }
 
+// TestHandleInboundAllowlistedTransfer asserts that inbound transfers of
+// non-native tokens are accepted when the token is on the allowlist
+func (suite *TokenFilterTestSuite) TestHandleInboundAllowlistedTransfer() {
+	// setup between celestiaChain and otherChain
+	path := NewTransferPath(suite.celestiaChain, suite.otherChain)
+	suite.coordinator.Setup(path)
+
+	// allow the native token of otherChain
+	celestiaApp := suite.celestiaChain.App.(*app.App)
+	celestiaApp.TokenFilterKeeper.SetParams(suite.celestiaChain.GetContext(), tokenfilter.NewParams([]tokenfilter.AllowedDenom{
+		tokenfilter.NewAllowedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	}))
+	suite.coordinator.CommitBlock(suite.celestiaChain)
+
+	amount, ok := sdk.NewIntFromString("1000")
+	suite.Require().True(ok)
+	timeoutHeight := clienttypes.NewHeight(1, 110)
+	coinToSendToA := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+
+	// send from otherChain to celestiaChain
+	msg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinToSendToA, suite.otherChain.SenderAccount.GetAddress().String(), suite.celestiaChain.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err := suite.otherChain.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// relay send
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	// check that the voucher was minted on celestiaChain
+	voucherDenomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+	balance := celestiaApp.BankKeeper.GetBalance(suite.celestiaChain.GetContext(), suite.celestiaChain.SenderAccount.GetAddress(), voucherDenomTrace.IBCDenom())
+	suite.Require().Equal(sdk.NewCoin(voucherDenomTrace.IBCDenom(), amount), balance)
+}
+
 func TestTokenFilterTestSuite(t *testing.T) {
 	suite.Run(t, new(TokenFilterTestSuite))
 }
@@ -0,0 +1,155 @@
+// The go-ethereum simulated backend depends on github.com/fjl/memsize which
+// doesn't link with go1.23 and later.
+//go:build !go1.23
+
+// Package evm provides a Blobstream contract running on a go-ethereum simulated
+// backend so that the Blobstream verification flow can be tested without a
+// live EVM chain.
+package evm
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/client"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// simulatedChainID is the chain ID always used by the simulated backend.
+	simulatedChainID = 1337
+	// validatorPower is the power of the single Blobstream validator.
+	validatorPower = 100
+	// initialValsetNonce is the nonce of the validator set the contract is
+	// initialized with.
+	initialValsetNonce = 1
+)
+
+// SimulatedBlobstream is a Blobstream contract deployed on a go-ethereum
+// simulated backend. It implements client.AttestationVerifier by calling the
+// deployed contract.
+type SimulatedBlobstream struct {
+	*client.ContractVerifier
+
+	Backend *simulated.Backend
+	Address ethcmn.Address
+
+	contract     *wrapper.Wrappers
+	auth         *bind.TransactOpts
+	validatorKey *ecdsa.PrivateKey
+	validators   []wrapper.Validator
+	nonce        uint64
+}
+
+var _ client.AttestationVerifier = &SimulatedBlobstream{}
+
+// NewSimulatedBlobstream deploys a Blobstream contract, secured by a single
+// validator, on a new simulated backend. The backend is closed when the test
+// ends.
+func NewSimulatedBlobstream(t testing.TB) *SimulatedBlobstream {
+	deployerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	validatorKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	auth, err := bind.NewKeyedTransactorWithChainID(deployerKey, big.NewInt(simulatedChainID))
+	require.NoError(t, err)
+
+	backend := simulated.NewBackend(ethtypes.GenesisAlloc{
+		auth.From: {Balance: new(big.Int).Lsh(big.NewInt(1), 100)},
+	})
+	t.Cleanup(func() { _ = backend.Close() })
+
+	address, _, contract, err := wrapper.DeployWrappers(auth, backend.Client())
+	require.NoError(t, err)
+	backend.Commit()
+
+	valset := types.Valset{
+		Nonce: initialValsetNonce,
+		Members: []types.BridgeValidator{{
+			Power:      validatorPower,
+			EvmAddress: crypto.PubkeyToAddress(validatorKey.PublicKey).Hex(),
+		}},
+	}
+	valsetHash, err := valset.Hash()
+	require.NoError(t, err)
+	tx, err := contract.Initialize(
+		auth,
+		big.NewInt(initialValsetNonce),
+		new(big.Int).SetUint64(valset.TwoThirdsThreshold()),
+		valsetHash,
+	)
+	require.NoError(t, err)
+	backend.Commit()
+	requireSuccess(t, backend, tx)
+
+	verifier, err := client.NewContractVerifier(address, backend.Client())
+	require.NoError(t, err)
+
+	return &SimulatedBlobstream{
+		ContractVerifier: verifier,
+		Backend:          backend,
+		Address:          address,
+		contract:         contract,
+		auth:             auth,
+		validatorKey:     validatorKey,
+		validators: []wrapper.Validator{{
+			Addr:  crypto.PubkeyToAddress(validatorKey.PublicKey),
+			Power: big.NewInt(validatorPower),
+		}},
+		nonce: initialValsetNonce,
+	}
+}
+
+// SubmitDataRootTupleRoot relays the data root tuple root to the contract,
+// signed by the validator set, and returns the nonce it was committed with.
+func (s *SimulatedBlobstream) SubmitDataRootTupleRoot(t testing.TB, dataRootTupleRoot []byte) uint64 {
+	require.Len(t, dataRootTupleRoot, 32)
+	root := *(*[32]byte)(dataRootTupleRoot)
+	nonce := s.nonce + 1
+
+	// the contract verifies EIP-191 signatures over the sign bytes
+	signBytes := types.DataRootTupleRootSignBytes(nonce, root)
+	signature, err := crypto.Sign(accounts.TextHash(signBytes.Bytes()), s.validatorKey)
+	require.NoError(t, err)
+
+	tx, err := s.contract.SubmitDataRootTupleRoot(
+		s.auth,
+		new(big.Int).SetUint64(nonce),
+		big.NewInt(initialValsetNonce),
+		root,
+		s.validators,
+		[]wrapper.Signature{{
+			V: signature[64] + 27,
+			R: *(*[32]byte)(signature[:32]),
+			S: *(*[32]byte)(signature[32:64]),
+		}},
+	)
+	require.NoError(t, err)
+	s.Backend.Commit()
+	requireSuccess(t, s.Backend, tx)
+
+	s.nonce = nonce
+	return nonce
+}
+
+// Nonce returns the nonce of the latest attestation relayed to the contract.
+func (s *SimulatedBlobstream) Nonce() uint64 {
+	return s.nonce
+}
+
+func requireSuccess(t testing.TB, backend *simulated.Backend, tx *ethtypes.Transaction) {
+	receipt, err := backend.Client().TransactionReceipt(context.Background(), tx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+}
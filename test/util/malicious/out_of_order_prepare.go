@@ -39,6 +39,7 @@ func (a *App) OutOfOrderPrepareProposal(req abci.RequestPrepareProposal) abci.Re
 		ante.DefaultSigVerificationGasConsumer,
 		a.IBCKeeper,
 		a.ParamsKeeper,
+		a.ParamFilterKeeper,
 		a.MsgGateKeeper,
 	)
 
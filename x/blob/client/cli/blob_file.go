@@ -0,0 +1,464 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user/compression"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	core "github.com/tendermint/tendermint/rpc/core"
+)
+
+const (
+	// FlagBlobFile allows the user to provide the path to a binary file that
+	// is split into blobs submitted in sequential PayForBlobs.
+	FlagBlobFile = "blob-file"
+
+	// FlagManifest allows the user to override the path of the manifest
+	// written when submitting a FlagBlobFile.
+	FlagManifest = "manifest"
+
+	// FlagChunkSize allows the user to lower the size of the blobs a
+	// FlagBlobFile is split into.
+	FlagChunkSize = "chunk-size"
+
+	// ManifestExtension is the extension appended to the path of the
+	// FlagBlobFile to get the default path of its manifest.
+	ManifestExtension = ".manifest.json"
+
+	// blobFileTxTimeout is the time to wait for a PayForBlobs of a
+	// FlagBlobFile to be committed.
+	blobFileTxTimeout = 5 * time.Minute
+
+	// blobFileTxPollInterval is the interval at which the status of a
+	// PayForBlobs of a FlagBlobFile is polled.
+	blobFileTxPollInterval = time.Second
+)
+
+// BlobFileManifest records how a file was split into blobs and where they were
+// published so that the file can be reassembled from the chain.
+type BlobFileManifest struct {
+	// File is the name of the file.
+	File string `json:"file"`
+	Size int64  `json:"size"`
+	// SHA256 is the hex encoded SHA-256 hash of the file.
+	SHA256 string `json:"sha256"`
+	// Namespace is the hex encoded namespace of the blobs.
+	Namespace    string `json:"namespace"`
+	ShareVersion uint8  `json:"share_version"`
+	// Compression is the algorithm the data of the blobs is compressed with,
+	// if any.
+	Compression string `json:"compression,omitempty"`
+	// Chunks are the blobs of the file, in the order of the file.
+	Chunks []BlobFileChunk `json:"chunks"`
+}
+
+// BlobFileChunk is a blob containing a part of a file.
+type BlobFileChunk struct {
+	Index int `json:"index"`
+	// Offset is the offset of the chunk in the file.
+	Offset int64 `json:"offset"`
+	// Size is the size of the chunk in the file, i.e. the size of the
+	// decompressed data of the blob.
+	Size int `json:"size"`
+	// Height is the height of the block containing the PayForBlobs.
+	Height int64  `json:"height"`
+	TxHash string `json:"tx_hash"`
+	// Commitment is the base64 encoded share commitment of the blob.
+	Commitment string `json:"commitment"`
+}
+
+// submitBlobFile splits the file at path into blobs and submits them in
+// sequential PayForBlobs, each one waiting for the previous one to be
+// committed. The manifest is written after every committed PayForBlobs so that
+// it records the chunks published so far if the submission fails.
+func submitBlobFile(cmd *cobra.Command, namespaceIDArg, path string, namespaceVersion, shareVersion uint8) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+	if clientCtx.GenerateOnly || clientCtx.Simulate {
+		return fmt.Errorf("--%s is not supported with --%s or --%s", FlagBlobFile, flags.FlagGenerateOnly, flags.FlagDryRun)
+	}
+	namespaceID, err := hex.DecodeString(strings.TrimPrefix(namespaceIDArg, "0x"))
+	if err != nil {
+		return fmt.Errorf("failed to decode hex namespace ID: %w", err)
+	}
+	namespace, err := getNamespace(namespaceID, namespaceVersion)
+	if err != nil {
+		return err
+	}
+	manifestPath, err := cmd.Flags().GetString(FlagManifest)
+	if err != nil {
+		return err
+	}
+	if manifestPath == "" {
+		manifestPath = path + ManifestExtension
+	}
+	chunkSize, err := cmd.Flags().GetInt(FlagChunkSize)
+	if err != nil {
+		return err
+	}
+	maxChunkSize, err := queryMaxChunkSize(cmd.Context(), clientCtx, shareVersion)
+	if err != nil {
+		return err
+	}
+	switch {
+	case chunkSize == 0:
+		chunkSize = maxChunkSize
+	case chunkSize < 0 || chunkSize > maxChunkSize:
+		return fmt.Errorf("chunk size %d must be between 1 and %d, the max size of a blob in the current max square size", chunkSize, maxChunkSize)
+	}
+	algorithm, err := getCompression(cmd)
+	if err != nil {
+		return err
+	}
+	// the size of the data read for a chunk must be lowered so that the blob
+	// fits in the chunk size even if the data is incompressible.
+	readSize := chunkSize
+	if algorithm != compression.None {
+		for readSize > 0 && compression.MaxCompressedSize(readSize, algorithm) > chunkSize {
+			readSize -= compression.MaxCompressedSize(readSize, algorithm) - chunkSize
+		}
+		if readSize <= 0 {
+			return fmt.Errorf("chunk size %d is too small to fit compressed data", chunkSize)
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() == 0 {
+		return errors.New("the blob file is empty")
+	}
+
+	manifest := BlobFileManifest{
+		File:         filepath.Base(path),
+		Size:         info.Size(),
+		Namespace:    hex.EncodeToString(namespace.Bytes()),
+		ShareVersion: shareVersion,
+	}
+	if algorithm != compression.None {
+		manifest.Compression = algorithm.String()
+	}
+	hash := sha256.New()
+	data := make([]byte, readSize)
+	var offset int64
+	for index := 0; offset < info.Size(); index++ {
+		n, err := io.ReadFull(file, data)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return err
+		}
+		hash.Write(data[:n])
+
+		blobData := bytes.Clone(data[:n])
+		if algorithm != compression.None {
+			if blobData, err = compression.Compress(blobData, algorithm); err != nil {
+				return err
+			}
+		}
+		blob, err := newBlob(namespace, blobData, shareVersion, clientCtx.FromAddress)
+		if err != nil {
+			return err
+		}
+		chunk, err := broadcastBlobFileChunk(cmd, clientCtx, blob)
+		if err != nil {
+			return fmt.Errorf("submitting chunk %d at offset %d: %w", index, offset, err)
+		}
+		chunk.Index = index
+		chunk.Offset = offset
+		chunk.Size = n
+		manifest.Chunks = append(manifest.Chunks, chunk)
+		offset += int64(n)
+		if offset == info.Size() {
+			manifest.SHA256 = hex.EncodeToString(hash.Sum(nil))
+		}
+		if err := writeManifest(manifestPath, manifest); err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "submitted chunk %d (%d bytes) in tx %s at height %d\n", index, n, chunk.TxHash, chunk.Height)
+	}
+	_, err = fmt.Fprintf(cmd.OutOrStdout(), "submitted %s in %d blobs, manifest written to %s\n", path, len(manifest.Chunks), manifestPath)
+	return err
+}
+
+// broadcastBlobFileChunk submits the blob in a PayForBlobs and waits for it to
+// be committed.
+func broadcastBlobFileChunk(cmd *cobra.Command, clientCtx client.Context, blob *share.Blob) (BlobFileChunk, error) {
+	blobTx, pfbMsg, err := newBlobTx(cmd, clientCtx, blob)
+	if err != nil {
+		return BlobFileChunk{}, err
+	}
+	if blobTx == nil {
+		return BlobFileChunk{}, errors.New("the transaction was not signed")
+	}
+	res, err := clientCtx.BroadcastTx(blobTx)
+	if err != nil {
+		return BlobFileChunk{}, err
+	}
+	if res.Code != abci.CodeTypeOK {
+		return BlobFileChunk{}, fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+	height, err := confirmBlobTx(cmd.Context(), clientCtx, res.TxHash)
+	if err != nil {
+		return BlobFileChunk{}, err
+	}
+	return BlobFileChunk{
+		Height:     height,
+		TxHash:     res.TxHash,
+		Commitment: base64.StdEncoding.EncodeToString(pfbMsg.ShareCommitments[0]),
+	}, nil
+}
+
+// confirmBlobTx waits for the tx to be committed and returns its height.
+func confirmBlobTx(ctx context.Context, clientCtx client.Context, txHash string) (int64, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, blobFileTxTimeout)
+	defer cancel()
+	pollTicker := time.NewTicker(blobFileTxPollInterval)
+	defer pollTicker.Stop()
+
+	for {
+		resp, err := node.TxStatus(ctx, hash)
+		if err != nil {
+			return 0, err
+		}
+		switch resp.Status {
+		case core.TxStatusPending:
+			select {
+			case <-ctx.Done():
+				return 0, fmt.Errorf("waiting for tx %s: %w", txHash, ctx.Err())
+			case <-pollTicker.C:
+			}
+		case core.TxStatusCommitted:
+			if resp.ExecutionCode != abci.CodeTypeOK {
+				return 0, fmt.Errorf("tx %s failed with code %d: %s", txHash, resp.ExecutionCode, resp.Error)
+			}
+			return resp.Height, nil
+		case core.TxStatusEvicted:
+			return 0, fmt.Errorf("tx %s was evicted from the mempool", txHash)
+		default:
+			return 0, fmt.Errorf("unknown tx: %s", txHash)
+		}
+	}
+}
+
+// queryMaxChunkSize returns the max size of a blob of a FlagBlobFile according
+// to the current max effective square size of the chain.
+func queryMaxChunkSize(ctx context.Context, clientCtx client.Context, shareVersion uint8) (int, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+	info, err := node.ABCIInfo(ctx)
+	if err != nil {
+		return 0, err
+	}
+	params, err := types.NewQueryClient(clientCtx).Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return 0, err
+	}
+	squareSize := min(appconsts.SquareSizeUpperBound(info.Response.AppVersion), int(params.Params.GovMaxSquareSize))
+	return maxChunkSize(squareSize, shareVersion), nil
+}
+
+// maxChunkSize returns the max size of a blob that can be paid for by a
+// PayForBlobs in a square of the given size. The BlobShareDecorator allows the
+// blob to use all the shares of the square but one. However in a large square
+// a blob that large must start at the beginning of a row, so a row is left for
+// the PayForBlobs and the padding in front of the blob.
+func maxChunkSize(squareSize int, shareVersion uint8) int {
+	size := share.AvailableBytesFromSparseShares(squareSize * (squareSize - 1))
+	if shareVersion == share.ShareVersionOne {
+		size -= share.SignerSize
+	}
+	return size
+}
+
+func writeManifest(path string, manifest BlobFileManifest) error {
+	bz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(bz, '\n'), 0o600)
+}
+
+// CmdReassembleBlobFile returns a command that reassembles a file submitted
+// with FlagBlobFile from its manifest.
+func CmdReassembleBlobFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reassemble-file [manifest] [output]",
+		Short: "Reassemble a file submitted with pay-for-blob --blob-file from its manifest",
+		Long: `Reads the manifest written by pay-for-blob --blob-file, fetches the blobs of the file from the blocks
+recorded in the manifest and writes the file to output. The share commitment of every blob and the SHA-256 hash
+of the file are checked against the manifest.`,
+		Example: "celestia-appd query blob reassemble-file data.bin.manifest.json data.bin --node tcp://localhost:26657\n",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var manifest BlobFileManifest
+			if err := json.Unmarshal(bz, &manifest); err != nil {
+				return fmt.Errorf("decoding manifest: %w", err)
+			}
+			fetch := func(chunk BlobFileChunk) (*share.Blob, error) {
+				return fetchBlobFileChunk(cmd.Context(), clientCtx, chunk)
+			}
+
+			file, err := os.Create(args[1])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			if err := ReassembleBlobFile(file, manifest, fetch); err != nil {
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "reassembled %s from %d blobs to %s\n", manifest.File, len(manifest.Chunks), args[1])
+			return err
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// ReassembleBlobFile writes the file of the manifest to w. fetch returns the
+// blob of a chunk. The blobs are checked against the namespace, sizes and
+// commitments of the manifest and the file against its hash.
+func ReassembleBlobFile(w io.Writer, manifest BlobFileManifest, fetch func(BlobFileChunk) (*share.Blob, error)) error {
+	namespace, err := hex.DecodeString(manifest.Namespace)
+	if err != nil {
+		return fmt.Errorf("invalid namespace: %w", err)
+	}
+	if manifest.Compression != "" {
+		if _, err := compression.ParseAlgorithm(manifest.Compression); err != nil {
+			return err
+		}
+	}
+	if manifest.SHA256 == "" {
+		return errors.New("the manifest is incomplete: the submission of the file didn't complete")
+	}
+	hash := sha256.New()
+	var offset int64
+	for i, chunk := range manifest.Chunks {
+		if chunk.Index != i || chunk.Offset != offset {
+			return fmt.Errorf("chunk %d at offset %d is out of order", chunk.Index, chunk.Offset)
+		}
+		blob, err := fetch(chunk)
+		if err != nil {
+			return fmt.Errorf("fetching chunk %d: %w", chunk.Index, err)
+		}
+		if !bytes.Equal(blob.Namespace().Bytes(), namespace) {
+			return fmt.Errorf("the namespace of the blob of chunk %d doesn't match the manifest", chunk.Index)
+		}
+		commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.DefaultSubtreeRootThreshold)
+		if err != nil {
+			return err
+		}
+		if base64.StdEncoding.EncodeToString(commitment) != chunk.Commitment {
+			return fmt.Errorf("the commitment of the blob of chunk %d doesn't match the manifest", chunk.Index)
+		}
+		data := blob.Data()
+		if manifest.Compression != "" {
+			if data, err = compression.Decompress(data); err != nil {
+				return fmt.Errorf("decompressing chunk %d: %w", chunk.Index, err)
+			}
+		}
+		if len(data) != chunk.Size {
+			return fmt.Errorf("the blob of chunk %d has %d bytes instead of %d", chunk.Index, len(data), chunk.Size)
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+		hash.Write(data)
+		offset += int64(chunk.Size)
+	}
+	if offset != manifest.Size {
+		return fmt.Errorf("the chunks have %d bytes instead of %d", offset, manifest.Size)
+	}
+	if hex.EncodeToString(hash.Sum(nil)) != manifest.SHA256 {
+		return errors.New("the SHA-256 hash of the reassembled file doesn't match the manifest")
+	}
+	return nil
+}
+
+// fetchBlobFileChunk returns the blob of the chunk from the block recorded in
+// the chunk.
+func fetchBlobFileChunk(ctx context.Context, clientCtx client.Context, chunk BlobFileChunk) (*share.Blob, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	res, err := node.Block(ctx, &chunk.Height)
+	if err != nil {
+		return nil, err
+	}
+	for _, rawTx := range res.Block.Data.Txs {
+		// the hash of a blob tx is the hash of the tx it wraps.
+		if fmt.Sprintf("%X", rawTx.Hash()) != chunk.TxHash {
+			continue
+		}
+		blobTx, isBlobTx, err := tx.UnmarshalBlobTx(rawTx)
+		if err != nil {
+			return nil, err
+		}
+		if !isBlobTx || len(blobTx.Blobs) != 1 {
+			return nil, fmt.Errorf("tx %s is not a blob tx with a single blob", chunk.TxHash)
+		}
+		return blobTx.Blobs[0], nil
+	}
+	return nil, fmt.Errorf("tx %s not found in block %d", chunk.TxHash, chunk.Height)
+}
+
+func newBlob(namespace share.Namespace, data []byte, shareVersion uint8, signer sdk.AccAddress) (*share.Blob, error) {
+	switch shareVersion {
+	case share.ShareVersionZero:
+		return types.NewV0Blob(namespace, data)
+	case share.ShareVersionOne:
+		return types.NewV1Blob(namespace, data, signer)
+	default:
+		return nil, fmt.Errorf("share version %d is not supported", shareVersion)
+	}
+}
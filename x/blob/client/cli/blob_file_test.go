@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestMaxChunkSize(t *testing.T) {
+	namespace := share.RandomBlobNamespace()
+	for _, squareSize := range []int{2, 8, int(appconsts.DefaultGovMaxSquareSize)} {
+		t.Run(fmt.Sprintf("square size %d", squareSize), func(t *testing.T) {
+			// a blob of the max chunk size fits in the square with its PFB
+			blob, err := share.NewV0Blob(namespace, tmrand.Bytes(maxChunkSize(squareSize, share.ShareVersionZero)))
+			require.NoError(t, err)
+			blobTx, err := tx.MarshalBlobTx(tmrand.Bytes(300), blob)
+			require.NoError(t, err)
+			_, err = square.Construct([][]byte{blobTx}, squareSize, appconsts.DefaultSubtreeRootThreshold)
+			assert.NoError(t, err)
+		})
+	}
+
+	// a blob using all the shares of the square but one doesn't fit in a large
+	// square as it must start at the beginning of a row.
+	squareSize := int(appconsts.DefaultGovMaxSquareSize)
+	blob, err := share.NewV0Blob(namespace, tmrand.Bytes(share.AvailableBytesFromSparseShares(squareSize*squareSize-1)))
+	require.NoError(t, err)
+	blobTx, err := tx.MarshalBlobTx(tmrand.Bytes(300), blob)
+	require.NoError(t, err)
+	_, err = square.Construct([][]byte{blobTx}, squareSize, appconsts.DefaultSubtreeRootThreshold)
+	assert.Error(t, err)
+
+	assert.Equal(t, maxChunkSize(8, share.ShareVersionZero)-share.SignerSize, maxChunkSize(8, share.ShareVersionOne))
+}
+
+func TestReassembleBlobFile(t *testing.T) {
+	namespace := share.RandomBlobNamespace()
+	data := tmrand.Bytes(2500)
+	hash := sha256.Sum256(data)
+	manifest := BlobFileManifest{
+		File:      "data.bin",
+		Size:      int64(len(data)),
+		SHA256:    hex.EncodeToString(hash[:]),
+		Namespace: hex.EncodeToString(namespace.Bytes()),
+	}
+	blobs := make(map[string]*share.Blob)
+	for offset := 0; offset < len(data); offset += 1000 {
+		blob, err := share.NewV0Blob(namespace, data[offset:min(offset+1000, len(data))])
+		require.NoError(t, err)
+		commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.DefaultSubtreeRootThreshold)
+		require.NoError(t, err)
+		chunk := BlobFileChunk{
+			Index:      len(manifest.Chunks),
+			Offset:     int64(offset),
+			Size:       len(blob.Data()),
+			Height:     int64(10 + len(manifest.Chunks)),
+			TxHash:     fmt.Sprintf("%X", tmrand.Bytes(32)),
+			Commitment: base64.StdEncoding.EncodeToString(commitment),
+		}
+		manifest.Chunks = append(manifest.Chunks, chunk)
+		blobs[chunk.TxHash] = blob
+	}
+	fetch := func(chunk BlobFileChunk) (*share.Blob, error) {
+		return blobs[chunk.TxHash], nil
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, ReassembleBlobFile(&buf, manifest, fetch))
+	assert.Equal(t, data, buf.Bytes())
+
+	tests := []struct {
+		name   string
+		modify func(m *BlobFileManifest)
+	}{
+		{
+			name:   "incomplete manifest",
+			modify: func(m *BlobFileManifest) { m.SHA256 = "" },
+		},
+		{
+			name:   "missing chunk",
+			modify: func(m *BlobFileManifest) { m.Chunks = m.Chunks[:len(m.Chunks)-1] },
+		},
+		{
+			name:   "chunks out of order",
+			modify: func(m *BlobFileManifest) { m.Chunks[0], m.Chunks[1] = m.Chunks[1], m.Chunks[0] },
+		},
+		{
+			name:   "other commitment",
+			modify: func(m *BlobFileManifest) { m.Chunks[1].Commitment = m.Chunks[0].Commitment },
+		},
+		{
+			name:   "other namespace",
+			modify: func(m *BlobFileManifest) { m.Namespace = hex.EncodeToString(share.RandomBlobNamespace().Bytes()) },
+		},
+		{
+			name:   "other hash",
+			modify: func(m *BlobFileManifest) { m.SHA256 = hex.EncodeToString(make([]byte, sha256.Size)) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modified := manifest
+			modified.Chunks = append([]BlobFileChunk{}, manifest.Chunks...)
+			tt.modify(&modified)
+			assert.Error(t, ReassembleBlobFile(&bytes.Buffer{}, modified, fetch))
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user/compression"
 	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
 	"github.com/celestiaorg/go-square/v2/share"
 	"github.com/celestiaorg/go-square/v2/tx"
@@ -38,6 +39,10 @@ const (
 	// FileInputExtension is the only file extension supported for
 	// FlagFileInput.
 	FileInputExtension = ".json"
+
+	// FlagCompress allows the user to compress the data of the blobs with the
+	// framing of the pkg/user/compression package.
+	FlagCompress = "compress"
 )
 
 func CmdPayForBlob() *cobra.Command {
@@ -54,6 +59,12 @@ func CmdPayForBlob() *cobra.Command {
 			"\t--from validator \\\n" +
 			"\t--keyring-backend test \\\n" +
 			"\t--fees 21000utia \\\n" +
+			"\t--yes \n\n" +
+			"celestia-appd tx blob pay-for-blob 0x00010203040506070809 --blob-file path/to/data.bin \\\n" +
+			"\t--chain-id private \\\n" +
+			"\t--from validator \\\n" +
+			"\t--keyring-backend test \\\n" +
+			"\t--fees 21000utia \\\n" +
 			"\t--yes \n",
 		Short: "Pay for data blob(s) to be published to Celestia.",
 		Long: `Pay for data blob(s) to be published to Celestia.
@@ -77,6 +88,17 @@ The JSON should look like:
 The namespaceID is the user-specifiable portion of a version 0 namespace.
 The namespaceID must be a hex encoded string of 10 bytes.
 The blob must be a hex encoded string of non-zero length.
+
+To publish a binary file of any size, specify the namespaceID via CLI argument and the path to the file with
+the --blob-file flag. The file is split into blobs that fit in the current max square size, or in blobs of
+--chunk-size bytes, which are submitted in sequential PayForBlobs, each one waiting for the previous one to be
+committed. A manifest recording the height, tx hash and share commitment of every blob, in the order of the
+file, is written to the --manifest path, by default the path of the file with the .manifest.json extension.
+The file can be reassembled from the manifest with "celestia-appd query blob reassemble-file".
+
+To pay for less bytes, the data of the blobs can be compressed with the --compress flag, using zstd by default or
+snappy with --compress=snappy. The compressed data starts with a header describing how to decompress it, see the
+pkg/user/compression package to decompress it.
 		`,
 		Aliases: []string{"pay-for-blobs", "PayForBlobs", "PayForBlob"},
 		Args: func(cmd *cobra.Command, args []string) error {
@@ -85,6 +107,22 @@ The blob must be a hex encoded string of non-zero length.
 				return err
 			}
 
+			blobFile, err := cmd.Flags().GetString(FlagBlobFile)
+			if err != nil {
+				return err
+			}
+
+			if blobFile != "" {
+				if path != "" {
+					return fmt.Errorf("only one of %s and %s can be provided", FlagFileInput, FlagBlobFile)
+				}
+				if len(args) != 1 {
+					return fmt.Errorf("pay-for-blob requires one argument if %s is provided: namespaceID", FlagBlobFile)
+				}
+
+				return nil
+			}
+
 			if path != "" {
 				if filepath.Ext(path) != FileInputExtension {
 					return fmt.Errorf("invalid file extension %v. The only supported extension is %s", filepath.Ext(path), FileInputExtension)
@@ -122,6 +160,14 @@ The blob must be a hex encoded string of non-zero length.
 
 			signer := clientCtx.FromAddress
 
+			blobFile, err := cmd.Flags().GetString(FlagBlobFile)
+			if err != nil {
+				return err
+			}
+			if blobFile != "" {
+				return submitBlobFile(cmd, args[0], blobFile, namespaceVersion, shareVersion)
+			}
+
 			// In case of no file input, get the namespaceID and blob from the arguments
 			if path == "" {
 				blob, err := getBlobFromArguments(args[0], args[1], namespaceVersion, shareVersion, signer)
@@ -154,6 +200,11 @@ The blob must be a hex encoded string of non-zero length.
 	cmd.PersistentFlags().Uint8(FlagNamespaceVersion, 0, "Specify the namespace version (default 0)")
 	cmd.PersistentFlags().Uint8(FlagShareVersion, 0, "Specify the share version (default 0)")
 	cmd.PersistentFlags().String(FlagFileInput, "", "Specify the file input")
+	cmd.PersistentFlags().String(FlagBlobFile, "", "Specify a binary file to split into blobs submitted in sequential PayForBlobs")
+	cmd.PersistentFlags().String(FlagManifest, "", "Specify the path of the manifest of the --blob-file (default the file path with the .manifest.json extension)")
+	cmd.PersistentFlags().Int(FlagChunkSize, 0, "Specify the max size in bytes of the blobs of the --blob-file (default the max size of a blob in the current max square size)")
+	cmd.PersistentFlags().String(FlagCompress, "", fmt.Sprintf("Compress the data of the blobs with one of %s (zstd if set without value)", strings.Join(compression.Algorithms(), ", ")))
+	cmd.PersistentFlags().Lookup(FlagCompress).NoOptDefVal = compression.Zstd.String()
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 	return cmd
 }
@@ -173,14 +224,7 @@ func getBlobFromArguments(namespaceIDArg, blobArg string, namespaceVersion, shar
 		return nil, fmt.Errorf("failure to decode hex blob value %s: %s", hexStr, err.Error())
 	}
 
-	switch shareVersion {
-	case share.ShareVersionZero:
-		return types.NewV0Blob(namespace, rawblob)
-	case share.ShareVersionOne:
-		return types.NewV1Blob(namespace, rawblob, signer)
-	default:
-		return nil, fmt.Errorf("share version %d is not supported", shareVersion)
-	}
+	return newBlob(namespace, rawblob, shareVersion, signer)
 }
 
 func getNamespace(namespaceID []byte, namespaceVersion uint8) (share.Namespace, error) {
@@ -206,33 +250,68 @@ func broadcastPFB(cmd *cobra.Command, b ...*share.Blob) error {
 		return err
 	}
 
-	pfbMsg, err := types.NewMsgPayForBlobs(clientCtx.FromAddress.String(), appconsts.LatestVersion, b...)
+	algorithm, err := getCompression(cmd)
 	if err != nil {
 		return err
 	}
+	if algorithm != compression.None {
+		for i := range b {
+			if b[i], err = compression.CompressBlob(b[i], algorithm); err != nil {
+				return err
+			}
+		}
+	}
 
-	// run message checks
-	if err = pfbMsg.ValidateBasic(); err != nil {
+	blobTx, _, err := newBlobTx(cmd, clientCtx, b...)
+	if err != nil {
 		return err
 	}
 
-	txBytes, err := writeTx(clientCtx, sdktx.NewFactoryCLI(clientCtx, cmd.Flags()), pfbMsg)
+	// broadcast to a Tendermint node
+	res, err := clientCtx.BroadcastTx(blobTx)
 	if err != nil {
 		return err
 	}
 
-	blobTx, err := tx.MarshalBlobTx(txBytes, b...)
+	return clientCtx.PrintProto(res)
+}
+
+// getCompression returns the compression algorithm of the FlagCompress, None
+// if the flag is not set.
+func getCompression(cmd *cobra.Command) (compression.Algorithm, error) {
+	name, err := cmd.Flags().GetString(FlagCompress)
 	if err != nil {
-		return err
+		return compression.None, err
+	}
+	if name == "" {
+		return compression.None, nil
 	}
+	return compression.ParseAlgorithm(name)
+}
 
-	// broadcast to a Tendermint node
-	res, err := clientCtx.BroadcastTx(blobTx)
+// newBlobTx creates and signs the PFB message paying for the blobs and returns
+// it wrapped with the blobs in a blob tx, along with the message.
+func newBlobTx(cmd *cobra.Command, clientCtx client.Context, b ...*share.Blob) ([]byte, *types.MsgPayForBlobs, error) {
+	pfbMsg, err := types.NewMsgPayForBlobs(clientCtx.FromAddress.String(), appconsts.LatestVersion, b...)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return clientCtx.PrintProto(res)
+	// run message checks
+	if err = pfbMsg.ValidateBasic(); err != nil {
+		return nil, nil, err
+	}
+
+	txBytes, err := writeTx(clientCtx, sdktx.NewFactoryCLI(clientCtx, cmd.Flags()), pfbMsg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blobTx, err := tx.MarshalBlobTx(txBytes, b...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return blobTx, pfbMsg, nil
 }
 
 // writeTx attempts to generate and sign a transaction using the normal
@@ -2,8 +2,10 @@ package testutil
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 
@@ -16,12 +18,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user/compression"
 	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
 
 	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
 	paycli "github.com/celestiaorg/celestia-app/v3/x/blob/client/cli"
 	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
 )
 
 // username is used to create a funded genesis account under this name
@@ -92,6 +97,7 @@ func (s *IntegrationTestSuite) TestSubmitPayForBlob() {
 	`, hex.EncodeToString(share.RandomBlobNamespaceID()), hexBlob, hex.EncodeToString(share.RandomBlobNamespaceID()), hexBlob)
 	validPropFile := createTestFile(s.T(), validBlob, true)
 	invalidPropFile := createTestFile(s.T(), validBlob, false)
+	compressedNamespaceID := share.RandomBlobNamespaceID()
 
 	testCases := []struct {
 		name         string
@@ -99,6 +105,8 @@ func (s *IntegrationTestSuite) TestSubmitPayForBlob() {
 		expectErr    bool
 		expectedCode uint32
 		respType     proto.Message
+		// compressed is true if the data of the blob is compressed
+		compressed bool
 	}{
 		{
 			name: "single blob valid transaction",
@@ -114,6 +122,22 @@ func (s *IntegrationTestSuite) TestSubmitPayForBlob() {
 			expectedCode: 0,
 			respType:     &sdk.TxResponse{},
 		},
+		{
+			name: "single compressed blob valid transaction",
+			args: []string{
+				hex.EncodeToString(compressedNamespaceID),
+				hexBlob,
+				fmt.Sprintf("--from=%s", username),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, sdk.NewInt(1000))).String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s", paycli.FlagCompress),
+			},
+			expectErr:    false,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+			compressed:   true,
+		},
 		{
 			name: "multiple blobs valid transaction",
 			args: []string{
@@ -182,6 +206,90 @@ func (s *IntegrationTestSuite) TestSubmitPayForBlob() {
 			res, err := testnode.QueryWithoutProof(s.ctx.Context, txResp.TxHash)
 			require.NoError(err)
 			require.Equal(abci.CodeTypeOK, res.TxResult.Code)
+
+			if tc.compressed {
+				namespaceID, err := hex.DecodeString(tc.args[0])
+				require.NoError(err)
+				namespace, err := share.NewV0Namespace(namespaceID)
+				require.NoError(err)
+				data, err := hex.DecodeString(tc.args[1])
+				require.NoError(err)
+				s.requireCompressedBlob(res.Height, namespace, data)
+			}
+		})
+	}
+}
+
+// requireCompressedBlob checks that the blob committed in the block at the
+// height in the namespace is compressed and decompresses to the data.
+func (s *IntegrationTestSuite) requireCompressedBlob(height int64, namespace share.Namespace, data []byte) {
+	require := s.Require()
+
+	res, err := s.ctx.Client.Block(s.ctx.GoContext(), &height)
+	require.NoError(err)
+	var found bool
+	for _, rawTx := range res.Block.Txs {
+		blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
+		if err != nil || !isBlobTx {
+			continue
+		}
+		for _, blob := range blobTx.Blobs {
+			if !blob.Namespace().Equals(namespace) {
+				continue
+			}
+			found = true
+			require.True(compression.IsCompressed(blob.Data()))
+			decompressed, err := compression.DecompressBlob(blob)
+			require.NoError(err)
+			require.Equal(data, decompressed)
+		}
+	}
+	require.True(found, "no blob in namespace %X at height %d", namespace.Bytes(), height)
+}
+
+func (s *IntegrationTestSuite) TestSubmitBlobFile() {
+	for _, compress := range []string{"", "zstd", "snappy"} {
+		s.Run(fmt.Sprintf("compression %q", compress), func() {
+			require := s.Require()
+
+			dir := s.T().TempDir()
+			data := tmrand.Bytes(2500)
+			blobFile := filepath.Join(dir, "data.bin")
+			require.NoError(os.WriteFile(blobFile, data, 0o600))
+
+			require.NoError(s.ctx.WaitForNextBlock())
+			_, err := clitestutil.ExecTestCLICmd(s.ctx.Context, paycli.CmdPayForBlob(), []string{
+				hex.EncodeToString(share.RandomBlobNamespaceID()),
+				fmt.Sprintf("--%s=%s", paycli.FlagBlobFile, blobFile),
+				fmt.Sprintf("--%s=%d", paycli.FlagChunkSize, 1000),
+				fmt.Sprintf("--%s=%s", paycli.FlagCompress, compress),
+				fmt.Sprintf("--from=%s", username),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, sdk.NewInt(1000))).String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			})
+			require.NoError(err)
+
+			bz, err := os.ReadFile(blobFile + paycli.ManifestExtension)
+			require.NoError(err)
+			var manifest paycli.BlobFileManifest
+			require.NoError(json.Unmarshal(bz, &manifest))
+			require.Equal(compress, manifest.Compression)
+			// the chunks are smaller than the chunk size if they are compressed
+			// so that they fit in it even if the data is incompressible.
+			require.GreaterOrEqual(len(manifest.Chunks), 3)
+			size := manifest.Chunks[0].Size
+			for i := 1; i < len(manifest.Chunks); i++ {
+				require.Greater(manifest.Chunks[i].Height, manifest.Chunks[i-1].Height)
+				size += manifest.Chunks[i].Size
+			}
+			require.Equal(len(data), size)
+
+			output := filepath.Join(dir, "reassembled.bin")
+			_, err = clitestutil.ExecTestCLICmd(s.ctx.Context, paycli.CmdReassembleBlobFile(), []string{blobFile + paycli.ManifestExtension, output})
+			require.NoError(err)
+			reassembled, err := os.ReadFile(output)
+			require.NoError(err)
+			require.Equal(data, reassembled)
 		})
 	}
 }
@@ -11,6 +11,7 @@ import (
 
 	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
 	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/stretchr/testify/assert"
@@ -558,7 +559,7 @@ func TestPruning(t *testing.T) {
 		assert.NoError(t, err)
 		assert.True(t, found)
 		// make sure the remaining attestations have not expired yet
-		assert.True(t, initialBlockTime.Before(at.BlockTime().Add(blobstream.AttestationExpiryTime)))
+		assert.True(t, initialBlockTime.Before(at.BlockTime().Add(bsKeeper.GetAttestationExpiryTimeParam(ctx))))
 	}
 
 	// check that no valset exists in store
@@ -579,3 +580,74 @@ func TestPruning(t *testing.T) {
 	// inconsistency happens after pruning
 	testutil.ExecuteBlobstreamHeightsWithTime(ctx, bsKeeper, 5000, 6000, blockInterval)
 }
+
+// TestPruningWithCustomExpiryTime tests that attestations are pruned after the
+// attestation expiry time param elapsed.
+func TestPruningWithCustomExpiryTime(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	bsKeeper := input.BlobstreamKeeper
+	bsKeeper.SetParams(ctx, types.Params{DataCommitmentWindow: 101, AttestationExpiryTime: 24 * time.Hour})
+	require.Equal(t, 24*time.Hour, bsKeeper.GetAttestationExpiryTimeParam(ctx))
+	blockInterval := 10 * time.Minute
+
+	// 1 day is 144 blocks so the attestations published in the first 1000
+	// blocks are expired by height 1150.
+	ctx = testutil.ExecuteBlobstreamHeightsWithTime(ctx, bsKeeper, 1, 1150, blockInterval)
+
+	earliestAttestationNonce := bsKeeper.GetEarliestAvailableAttestationNonce(ctx)
+	assert.Greater(t, earliestAttestationNonce, uint64(10))
+	for nonce := earliestAttestationNonce; nonce <= bsKeeper.GetLatestAttestationNonce(ctx); nonce++ {
+		at, found, err := bsKeeper.GetAttestationByNonce(ctx, nonce)
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.True(t, ctx.BlockTime().Before(at.BlockTime().Add(24*time.Hour)))
+	}
+}
+
+func TestValsetCreationWithCustomPowerDifferenceThreshold(t *testing.T) {
+	tests := []struct {
+		name              string
+		threshold         sdk.Dec
+		expectedNewValset bool
+	}{
+		{
+			name:              "default threshold",
+			threshold:         sdk.Dec{},
+			expectedNewValset: true,
+		},
+		{
+			name:              "threshold higher than the power difference",
+			threshold:         sdk.NewDecWithPrec(5, 1),
+			expectedNewValset: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, ctx := testutil.SetupFiveValChain(t)
+			pk := input.BlobstreamKeeper
+			pk.SetParams(ctx, types.Params{DataCommitmentWindow: 400, SignificantPowerDifferenceThreshold: tt.threshold})
+
+			ctx = ctx.WithBlockHeight(1)
+			staking.EndBlocker(ctx, input.StakingKeeper)
+			blobstream.EndBlocker(ctx, pk)
+			currentAttestationNonce := pk.GetLatestAttestationNonce(ctx)
+			require.Equal(t, uint64(1), currentAttestationNonce)
+
+			// undelegating half of the stake of a validator changes the
+			// normalized power of the validator set without it unbonding.
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+			msgServer := stakingkeeper.NewMsgServerImpl(input.StakingKeeper)
+			undelegateMsg := testutil.NewTestMsgUnDelegateValidator(testutil.ValAddrs[0], testutil.StakingAmount.QuoRaw(2))
+			_, err := msgServer.Undelegate(ctx, undelegateMsg)
+			require.NoError(t, err)
+			staking.EndBlocker(ctx, input.StakingKeeper)
+			blobstream.EndBlocker(ctx, pk)
+
+			if tt.expectedNewValset {
+				assert.Equal(t, currentAttestationNonce+1, pk.GetLatestAttestationNonce(ctx))
+			} else {
+				assert.Equal(t, currentAttestationNonce, pk.GetLatestAttestationNonce(ctx))
+			}
+		})
+	}
+}
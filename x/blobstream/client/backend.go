@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// AttestationVerifier looks up whether a data root tuple was committed to by
+// the data root tuple root with the given nonce that was relayed to the
+// Blobstream contract.
+type AttestationVerifier interface {
+	VerifyAttestation(ctx context.Context, nonce uint64, tuple wrapper.DataRootTuple, proof wrapper.BinaryMerkleProof) (bool, error)
+}
+
+var (
+	_ AttestationVerifier = &ContractVerifier{}
+	_ AttestationVerifier = &FileVerifier{}
+)
+
+// ContractVerifier verifies attestations by calling verifyAttestation on a
+// deployed Blobstream contract. Any contract caller can be used, e.g. an EVM
+// RPC client or the client of a go-ethereum simulated backend.
+type ContractVerifier struct {
+	caller *wrapper.WrappersCaller
+	closer func()
+}
+
+// NewContractVerifier returns a ContractVerifier for the Blobstream contract
+// deployed at address.
+func NewContractVerifier(address ethcmn.Address, caller bind.ContractCaller) (*ContractVerifier, error) {
+	wrapperCaller, err := wrapper.NewWrappersCaller(address, caller)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractVerifier{caller: wrapperCaller}, nil
+}
+
+// DialContractVerifier connects to the EVM RPC and returns a ContractVerifier
+// for the Blobstream contract deployed at address. The connection is closed
+// by Close.
+func DialContractVerifier(evmRPC string, address ethcmn.Address) (*ContractVerifier, error) {
+	ethClient, err := ethclient.Dial(evmRPC)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := NewContractVerifier(address, ethClient)
+	if err != nil {
+		ethClient.Close()
+		return nil, err
+	}
+	verifier.closer = ethClient.Close
+	return verifier, nil
+}
+
+// VerifyAttestation implements AttestationVerifier.
+func (v *ContractVerifier) VerifyAttestation(ctx context.Context, nonce uint64, tuple wrapper.DataRootTuple, p wrapper.BinaryMerkleProof) (bool, error) {
+	return v.caller.VerifyAttestation(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(nonce), tuple, p)
+}
+
+// Close releases the connection to the EVM RPC, if any.
+func (v *ContractVerifier) Close() {
+	if v.closer != nil {
+		v.closer()
+	}
+}
+
+// RelayedCommitment is a data root tuple root that was relayed to the
+// Blobstream contract.
+type RelayedCommitment struct {
+	Nonce             uint64        `json:"nonce"`
+	DataRootTupleRoot hexutil.Bytes `json:"data_root_tuple_root"`
+}
+
+// FileVerifier verifies attestations against a static set of relayed data
+// root tuple roots, mirroring the checks done by the Blobstream contract.
+type FileVerifier struct {
+	roots map[uint64][]byte
+}
+
+// NewFileVerifier returns a FileVerifier for the given relayed commitments.
+func NewFileVerifier(commitments []RelayedCommitment) (*FileVerifier, error) {
+	roots := make(map[uint64][]byte, len(commitments))
+	for _, c := range commitments {
+		if len(c.DataRootTupleRoot) != 32 {
+			return nil, fmt.Errorf("data root tuple root for nonce %d must be 32 bytes, got %d", c.Nonce, len(c.DataRootTupleRoot))
+		}
+		if _, ok := roots[c.Nonce]; ok {
+			return nil, fmt.Errorf("duplicate data root tuple root for nonce %d", c.Nonce)
+		}
+		roots[c.Nonce] = c.DataRootTupleRoot
+	}
+	return &FileVerifier{roots: roots}, nil
+}
+
+// LoadFileVerifier reads a JSON encoded list of RelayedCommitment from path
+// and returns a FileVerifier for them.
+func LoadFileVerifier(path string) (*FileVerifier, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var commitments []RelayedCommitment
+	if err := json.Unmarshal(bz, &commitments); err != nil {
+		return nil, fmt.Errorf("decoding relayed commitments: %w", err)
+	}
+	return NewFileVerifier(commitments)
+}
+
+// VerifyAttestation implements AttestationVerifier. It returns false if no
+// data root tuple root is known for the nonce.
+func (v *FileVerifier) VerifyAttestation(_ context.Context, nonce uint64, tuple wrapper.DataRootTuple, p wrapper.BinaryMerkleProof) (bool, error) {
+	root, ok := v.roots[nonce]
+	if !ok {
+		return false, nil
+	}
+	if !tuple.Height.IsUint64() || !p.Key.IsInt64() || !p.NumLeaves.IsInt64() {
+		return false, nil
+	}
+
+	leaf := proof.EncodeDataRootTuple(tuple.Height.Uint64(), tuple.DataRoot)
+	aunts := make([][]byte, len(p.SideNodes))
+	for i := range p.SideNodes {
+		aunts[i] = p.SideNodes[i][:]
+	}
+	merkleProof := merkle.Proof{
+		Total: p.NumLeaves.Int64(),
+		Index: p.Key.Int64(),
+		// leaves are prefixed with 0 before being hashed, as in RFC-6962
+		LeafHash: tmhash.Sum(append([]byte{0}, leaf...)),
+		Aunts:    aunts,
+	}
+	return merkleProof.Verify(root, leaf) == nil, nil
+}
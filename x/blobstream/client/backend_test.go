@@ -0,0 +1,222 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/client"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestVerifyProofBundleFileVerifier(t *testing.T) {
+	bundle, dataRootTupleRoot := newProofBundle(t)
+	bundle.Nonce = 3
+	otherNonce := uint64(2)
+
+	commitmentsFile := filepath.Join(t.TempDir(), "commitments.json")
+	bz, err := json.Marshal([]client.RelayedCommitment{
+		{Nonce: otherNonce, DataRootTupleRoot: tmrand.Bytes(32)},
+		{Nonce: bundle.Nonce, DataRootTupleRoot: dataRootTupleRoot},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(commitmentsFile, bz, 0o600))
+	verifier, err := client.LoadFileVerifier(commitmentsFile)
+	require.NoError(t, err)
+
+	testVerifyProofBundle(t, verifier, bundle, otherNonce)
+}
+
+func TestVerifyProofBundleContractVerifier(t *testing.T) {
+	bundle, dataRootTupleRoot := newProofBundle(t)
+	bundle.Nonce = 3
+	otherNonce := uint64(2)
+
+	verifier := newContractVerifier(t,
+		client.RelayedCommitment{Nonce: otherNonce, DataRootTupleRoot: tmrand.Bytes(32)},
+		client.RelayedCommitment{Nonce: bundle.Nonce, DataRootTupleRoot: dataRootTupleRoot},
+	)
+
+	testVerifyProofBundle(t, verifier, bundle, otherNonce)
+}
+
+// testVerifyProofBundle checks that verifier accepts the bundle, whose data
+// root tuple root was relayed, and rejects modified versions of it.
+// otherNonce must be the nonce of another relayed data root tuple root.
+func testVerifyProofBundle(t *testing.T, verifier client.AttestationVerifier, bundle proof.ProofBundle, otherNonce uint64) {
+	testCases := []struct {
+		name   string
+		modify func(b *proof.ProofBundle)
+		want   bool
+	}{
+		{
+			name:   "valid bundle",
+			modify: func(_ *proof.ProofBundle) {},
+			want:   true,
+		},
+		{
+			name:   "data root tuple root with another nonce",
+			modify: func(b *proof.ProofBundle) { b.Nonce = otherNonce },
+			want:   false,
+		},
+		{
+			name:   "data root tuple root not relayed",
+			modify: func(b *proof.ProofBundle) { b.Nonce += 10 },
+			want:   false,
+		},
+		{
+			name:   "wrong height",
+			modify: func(b *proof.ProofBundle) { b.Height++ },
+			want:   false,
+		},
+		{
+			name:   "modified share",
+			modify: func(b *proof.ProofBundle) { b.ShareProof.Data[0] = tmrand.Bytes(len(b.ShareProof.Data[0])) },
+			want:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := bundle
+			shareProof := *bundle.ShareProof
+			shareProof.Data = append([][]byte{}, bundle.ShareProof.Data...)
+			b.ShareProof = &shareProof
+			tc.modify(&b)
+
+			got, err := client.VerifyProofBundle(context.Background(), tmlog.NewNopLogger(), verifier, b)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNewFileVerifier(t *testing.T) {
+	_, err := client.NewFileVerifier([]client.RelayedCommitment{{Nonce: 1, DataRootTupleRoot: tmrand.Bytes(31)}})
+	require.Error(t, err)
+
+	_, err = client.NewFileVerifier([]client.RelayedCommitment{
+		{Nonce: 1, DataRootTupleRoot: tmrand.Bytes(32)},
+		{Nonce: 1, DataRootTupleRoot: tmrand.Bytes(32)},
+	})
+	require.Error(t, err)
+}
+
+// newVerifiers returns every AttestationVerifier backend for the given relayed
+// commitments.
+func newVerifiers(t *testing.T, commitments ...client.RelayedCommitment) map[string]client.AttestationVerifier {
+	fileVerifier, err := client.NewFileVerifier(commitments)
+	require.NoError(t, err)
+	return map[string]client.AttestationVerifier{
+		"file":     fileVerifier,
+		"contract": newContractVerifier(t, commitments...),
+	}
+}
+
+// newContractVerifier returns a ContractVerifier calling a contractCaller that
+// knows the given relayed commitments.
+func newContractVerifier(t *testing.T, commitments ...client.RelayedCommitment) *client.ContractVerifier {
+	contractABI, err := wrapper.WrappersMetaData.GetAbi()
+	require.NoError(t, err)
+	fileVerifier, err := client.NewFileVerifier(commitments)
+	require.NoError(t, err)
+	verifier, err := client.NewContractVerifier(ethcmn.HexToAddress("0x1"), &contractCaller{abi: contractABI, verifier: fileVerifier})
+	require.NoError(t, err)
+	return verifier
+}
+
+// contractCaller is a bind.ContractCaller that answers the verifyAttestation
+// calls of the Blobstream contract using a FileVerifier. It allows testing the
+// ABI encoding of the calls made by the ContractVerifier without an EVM chain.
+type contractCaller struct {
+	abi      *abi.ABI
+	verifier *client.FileVerifier
+}
+
+func (c *contractCaller) CodeAt(context.Context, ethcmn.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (c *contractCaller) CallContract(ctx context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if len(call.Data) < 4 {
+		return nil, fmt.Errorf("missing method ID")
+	}
+	method, err := c.abi.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	if method.Name != "verifyAttestation" {
+		return nil, fmt.Errorf("unexpected call to %s", method.Name)
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	nonce := args[0].(*big.Int)
+	tuple := *abi.ConvertType(args[1], new(wrapper.DataRootTuple)).(*wrapper.DataRootTuple)
+	p := *abi.ConvertType(args[2], new(wrapper.BinaryMerkleProof)).(*wrapper.BinaryMerkleProof)
+	valid, err := c.verifier.VerifyAttestation(ctx, nonce.Uint64(), tuple, p)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(valid)
+}
+
+// newProofBundle returns a proof bundle for a transaction share at height 3
+// of a data commitment over the blocks [1, 5) along with the data root tuple
+// root. The nonce of the bundle is not set.
+func newProofBundle(t *testing.T) (proof.ProofBundle, []byte) {
+	const (
+		height     = 3
+		beginBlock = 1
+		endBlock   = 5
+	)
+
+	txs := testfactory.GenerateRandomTxs(10, 500)
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	dataRoot := dah.Hash()
+
+	shareProof, err := proof.NewShareInclusionProof(dataSquare, share.TxNamespace, share.NewRange(0, 2))
+	require.NoError(t, err)
+
+	tuples := make([][]byte, 0, endBlock-beginBlock)
+	for h := uint64(beginBlock); h < endBlock; h++ {
+		root := *(*[32]byte)(tmrand.Bytes(32))
+		if h == height {
+			root = *(*[32]byte)(dataRoot)
+		}
+		tuples = append(tuples, proof.EncodeDataRootTuple(h, root))
+	}
+	dataRootTupleRoot, tupleProofs := merkle.ProofsFromByteSlices(tuples)
+
+	return proof.ProofBundle{
+		Height:             height,
+		DataRoot:           dataRoot,
+		ShareProof:         &shareProof,
+		BeginBlock:         beginBlock,
+		EndBlock:           endBlock,
+		DataRootTupleProof: proof.ProofFromMerkle(*tupleProofs[height-beginBlock]),
+	}, dataRootTupleRoot
+}
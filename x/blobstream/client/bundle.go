@@ -0,0 +1,223 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/spf13/cobra"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/rpc/client/http"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	outputFlag = "output"
+	formatFlag = "format"
+
+	formatJSON  = "json"
+	formatProto = "proto"
+)
+
+func exportProofCmd() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "export-proof <height> <start_share> <end_share>",
+		Args:  cobra.ExactArgs(3),
+		Short: "Exports a self-contained proof bundle for a range of shares that can be verified offline. The range should be end exclusive.",
+		Long: "Exports a self-contained proof bundle that a range of shares has been committed to by a Blobstream data commitment. " +
+			"The bundle contains the share proofs, the row proofs, the data root tuple inclusion proof and the data commitment nonce. " +
+			"It can be verified offline using the check-bundle command. The range should be end exclusive.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			startShare, err := strconv.ParseUint(args[1], 10, 0)
+			if err != nil {
+				return err
+			}
+			endShare, err := strconv.ParseUint(args[2], 10, 0)
+			if err != nil {
+				return err
+			}
+			tendermintRPC, err := cmd.Flags().GetString(flags.FlagNode)
+			if err != nil {
+				return err
+			}
+			celesGRPC, err := cmd.Flags().GetString(celesGRPCFlag)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(outputFlag)
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString(formatFlag)
+			if err != nil {
+				return err
+			}
+
+			logger := tmlog.NewTMLogger(os.Stderr)
+
+			bundle, err := ExportProofBundle(cmd.Context(), logger, tendermintRPC, celesGRPC, height, startShare, endShare)
+			if err != nil {
+				return err
+			}
+
+			bz, err := MarshalProofBundle(bundle, format)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				_, err = cmd.OutOrStdout().Write(bz)
+				return err
+			}
+			return os.WriteFile(output, bz, 0o600)
+		},
+	}
+	command.Flags().StringP(flags.FlagNode, "t", "http://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+	command.Flags().StringP(celesGRPCFlag, "c", "localhost:9090", "<host>:<port> To Celestia GRPC address")
+	command.Flags().StringP(outputFlag, "o", "", "The file to write the proof bundle to. Defaults to stdout")
+	command.Flags().String(formatFlag, formatJSON, "The encoding of the proof bundle (json|proto)")
+	return command
+}
+
+func checkBundleCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "check-bundle <bundle_file> <data_commitment_root>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Verifies a proof bundle offline against a data commitment root, in hex format",
+		Long: "Verifies a proof bundle, created using the export-proof command, offline against a data commitment root, in hex format. " +
+			"The data commitment root should be the one committed to by the Blobstream contract for the nonce contained in the bundle. " +
+			"Both the JSON and the protobuf encodings are accepted.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			bundle, err := UnmarshalProofBundle(bz)
+			if err != nil {
+				return err
+			}
+			dataCommitmentRoot, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			if err := bundle.Verify(dataCommitmentRoot); err != nil {
+				return fmt.Errorf("invalid proof bundle: %w", err)
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "the proof bundle is valid: the shares at height %d are committed to by the data commitment with nonce %d\n", bundle.Height, bundle.Nonce)
+			return err
+		},
+	}
+}
+
+// ExportProofBundle queries the proofs that the shares in [startShare,
+// endShare) at the given height are committed to by a Blobstream data
+// commitment and returns them as a ProofBundle.
+func ExportProofBundle(ctx context.Context, logger tmlog.Logger, tendermintRPC, celesGRPC string, height int64, startShare, endShare uint64) (proof.ProofBundle, error) {
+	if height <= 0 {
+		return proof.ProofBundle{}, fmt.Errorf("height must be a positive integer")
+	}
+	unsignedHeight := uint64(height)
+
+	trpc, err := http.New(tendermintRPC, "/websocket")
+	if err != nil {
+		return proof.ProofBundle{}, err
+	}
+	err = trpc.Start()
+	if err != nil {
+		return proof.ProofBundle{}, err
+	}
+	defer func(trpc *http.HTTP) {
+		err := trpc.Stop()
+		if err != nil {
+			logger.Debug("error closing connection", "err", err.Error())
+		}
+	}(trpc)
+
+	logger.Info("getting shares proof from tendermint node", "height", height, "start_share", startShare, "end_share", endShare)
+	sharesProof, err := trpc.ProveShares(ctx, unsignedHeight, startShare, endShare)
+	if err != nil {
+		return proof.ProofBundle{}, err
+	}
+
+	bsGRPC, err := grpc.NewClient(celesGRPC, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return proof.ProofBundle{}, err
+	}
+	defer func(bsGRPC *grpc.ClientConn) {
+		err := bsGRPC.Close()
+		if err != nil {
+			logger.Debug("error closing connection", "err", err.Error())
+		}
+	}(bsGRPC)
+
+	resp, err := types.NewQueryClient(bsGRPC).DataCommitmentRangeForHeight(
+		ctx,
+		&types.QueryDataCommitmentRangeForHeightRequest{Height: unsignedHeight},
+	)
+	if err != nil {
+		return proof.ProofBundle{}, err
+	}
+
+	logger.Info("getting the data root to commitment inclusion proof", "nonce", resp.DataCommitment.Nonce)
+	dcProof, err := trpc.DataRootInclusionProof(ctx, unsignedHeight, resp.DataCommitment.BeginBlock, resp.DataCommitment.EndBlock)
+	if err != nil {
+		return proof.ProofBundle{}, err
+	}
+
+	block, err := trpc.Block(ctx, &height)
+	if err != nil {
+		return proof.ProofBundle{}, err
+	}
+
+	shareProof := proof.ShareProofFromTendermint(sharesProof)
+	return proof.ProofBundle{
+		Height:             height,
+		DataRoot:           block.Block.DataHash,
+		ShareProof:         &shareProof,
+		Nonce:              resp.DataCommitment.Nonce,
+		BeginBlock:         resp.DataCommitment.BeginBlock,
+		EndBlock:           resp.DataCommitment.EndBlock,
+		DataRootTupleProof: proof.ProofFromMerkle(dcProof.Proof),
+	}, nil
+}
+
+// MarshalProofBundle encodes the bundle using the given format.
+func MarshalProofBundle(bundle proof.ProofBundle, format string) ([]byte, error) {
+	switch format {
+	case formatJSON:
+		return codec.ProtoMarshalJSON(&bundle, nil)
+	case formatProto:
+		return bundle.Marshal()
+	default:
+		return nil, fmt.Errorf("unsupported format %q, expected %s or %s", format, formatJSON, formatProto)
+	}
+}
+
+// UnmarshalProofBundle decodes a bundle that was encoded using either the JSON
+// or the protobuf format.
+func UnmarshalProofBundle(bz []byte) (proof.ProofBundle, error) {
+	var bundle proof.ProofBundle
+	if trimmed := bytes.TrimSpace(bz); len(trimmed) > 0 && trimmed[0] == '{' {
+		if err := jsonpb.Unmarshal(bytes.NewReader(trimmed), &bundle); err != nil {
+			return proof.ProofBundle{}, fmt.Errorf("decoding JSON proof bundle: %w", err)
+		}
+		return bundle, nil
+	}
+	if err := bundle.Unmarshal(bz); err != nil {
+		return proof.ProofBundle{}, fmt.Errorf("decoding protobuf proof bundle: %w", err)
+	}
+	return bundle, nil
+}
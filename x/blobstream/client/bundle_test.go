@@ -0,0 +1,42 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProofBundleEncoding(t *testing.T) {
+	bundle := proof.ProofBundle{
+		Height:   3,
+		DataRoot: []byte{1, 2, 3},
+		ShareProof: &proof.ShareProof{
+			Data:        [][]byte{{4, 5, 6}},
+			ShareProofs: []*proof.NMTProof{{Start: 0, End: 1, Nodes: [][]byte{{7}}}},
+			NamespaceId: []byte{8},
+			RowProof: &proof.RowProof{
+				RowRoots: [][]byte{{9}},
+				Proofs:   []*proof.Proof{{Total: 4, Index: 1, LeafHash: []byte{10}, Aunts: [][]byte{{11}}}},
+			},
+		},
+		Nonce:              2,
+		BeginBlock:         1,
+		EndBlock:           5,
+		DataRootTupleProof: &proof.Proof{Total: 4, Index: 2, LeafHash: []byte{12}, Aunts: [][]byte{{13}}},
+	}
+
+	for _, format := range []string{"json", "proto"} {
+		t.Run(format, func(t *testing.T) {
+			bz, err := client.MarshalProofBundle(bundle, format)
+			require.NoError(t, err)
+			got, err := client.UnmarshalProofBundle(bz)
+			require.NoError(t, err)
+			require.Equal(t, bundle, got)
+		})
+	}
+
+	_, err := client.MarshalProofBundle(bundle, "yaml")
+	require.Error(t, err)
+}
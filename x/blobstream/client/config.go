@@ -14,6 +14,23 @@ const (
 	celesGRPCFlag       = "celes-grpc"
 	evmRPCFlag          = "evm-rpc"
 	contractAddressFlag = "contract-address"
+	abiOutputFlag       = "abi-output"
+	commitmentsFileFlag = "commitments-file"
+)
+
+// The ABI output modes of the verify commands.
+const (
+	// ABIOutputSharesProof prints the ABI encoded SharesProof verified by the
+	// DAVerifier library.
+	ABIOutputSharesProof = "shares-proof"
+	// ABIOutputDataRootTuple prints the ABI encoded DataRootTuple.
+	ABIOutputDataRootTuple = "data-root-tuple"
+	// ABIOutputBinaryMerkleProof prints the ABI encoded BinaryMerkleProof of the
+	// data root tuple to the data root tuple root.
+	ABIOutputBinaryMerkleProof = "binary-merkle-proof"
+	// ABIOutputVerifyAttestation prints the calldata of a call to
+	// verifyAttestation on the Blobstream contract.
+	ABIOutputVerifyAttestation = "verify-attestation"
 )
 
 func addVerifyFlags(cmd *cobra.Command) *cobra.Command {
@@ -23,6 +40,11 @@ func addVerifyFlags(cmd *cobra.Command) *cobra.Command {
 	cmd.Flags().StringP(evmRPCFlag, "e", "http://localhost:8545", "The EVM RPC address")
 	cmd.Flags().StringP(contractAddressFlag, "a", "", "The contract address at which Blobstream is deployed")
 	cmd.Flags().StringP(celesGRPCFlag, "c", "localhost:9090", "<host>:<port> To Celestia GRPC address")
+	cmd.Flags().String(commitmentsFileFlag, "", "A JSON file of the data root tuple roots relayed to Blobstream, used instead of the EVM RPC and contract address")
+	cmd.Flags().String(abiOutputFlag, "", fmt.Sprintf(
+		"If set, prints the hex encoded Solidity ABI encoding of the proof to stdout and the logs to stderr (%s|%s|%s|%s)",
+		ABIOutputSharesProof, ABIOutputDataRootTuple, ABIOutputBinaryMerkleProof, ABIOutputVerifyAttestation,
+	))
 
 	return cmd
 }
@@ -32,6 +54,12 @@ type VerifyConfig struct {
 	EVMRPC, CelesGRPC, TendermintRPC string
 	EVMChainID                       uint64
 	ContractAddr                     ethcmn.Address
+	// CommitmentsFile is the path to a JSON file of relayed data commitments.
+	// If set, it is used instead of the Blobstream contract.
+	CommitmentsFile string
+	// ABIOutput is the ABI output mode. Empty if the proofs should not be
+	// printed.
+	ABIOutput string
 }
 
 func parseVerifyFlags(cmd *cobra.Command) (VerifyConfig, error) {
@@ -59,13 +87,29 @@ func parseVerifyFlags(cmd *cobra.Command) (VerifyConfig, error) {
 	if err != nil {
 		return VerifyConfig{}, err
 	}
-	if contractAddr == "" {
-		return VerifyConfig{}, fmt.Errorf("contract address flag is required: %s", contractAddressFlag)
+	commitmentsFile, err := cmd.Flags().GetString(commitmentsFileFlag)
+	if err != nil {
+		return VerifyConfig{}, err
+	}
+	var address ethcmn.Address
+	if commitmentsFile == "" {
+		if contractAddr == "" {
+			return VerifyConfig{}, fmt.Errorf("contract address flag is required: %s", contractAddressFlag)
+		}
+		if !ethcmn.IsHexAddress(contractAddr) {
+			return VerifyConfig{}, fmt.Errorf("valid contract address flag is required: %s", contractAddressFlag)
+		}
+		address = ethcmn.HexToAddress(contractAddr)
+	}
+	abiOutput, err := cmd.Flags().GetString(abiOutputFlag)
+	if err != nil {
+		return VerifyConfig{}, err
 	}
-	if !ethcmn.IsHexAddress(contractAddr) {
-		return VerifyConfig{}, fmt.Errorf("valid contract address flag is required: %s", contractAddressFlag)
+	switch abiOutput {
+	case "", ABIOutputSharesProof, ABIOutputDataRootTuple, ABIOutputBinaryMerkleProof, ABIOutputVerifyAttestation:
+	default:
+		return VerifyConfig{}, fmt.Errorf("unsupported ABI output %q", abiOutput)
 	}
-	address := ethcmn.HexToAddress(contractAddr)
 
 	return VerifyConfig{
 		CelestiaChainID: chainID,
@@ -74,5 +118,7 @@ func parseVerifyFlags(cmd *cobra.Command) (VerifyConfig, error) {
 		TendermintRPC:   tendermintRPC,
 		EVMRPC:          evmRPC,
 		ContractAddr:    address,
+		CommitmentsFile: commitmentsFile,
+		ABIOutput:       abiOutput,
 	}, nil
 }
@@ -24,7 +24,12 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdQueryAttestationByNonce(), CmdQueryEVMAddress())
+	cmd.AddCommand(
+		CmdQueryAttestationByNonce(),
+		CmdQueryAttestations(),
+		CmdQueryValsetsInRange(),
+		CmdQueryEVMAddress(),
+	)
 
 	return cmd
 }
@@ -76,6 +81,122 @@ func CmdQueryAttestationByNonce() *cobra.Command {
 	return cmd
 }
 
+const (
+	// FlagLimit is the maximum number of attestations to query.
+	FlagLimit = "limit"
+	// FlagType filters the queried attestations by type.
+	FlagType = "type"
+)
+
+// attestationTypes maps the values of the type flag to attestation types.
+var attestationTypes = map[string]types.AttestationType{
+	"":                types.AttestationTypeUnspecified,
+	"valset":          types.AttestationTypeValset,
+	"data-commitment": types.AttestationTypeDataCommitment,
+}
+
+func CmdQueryAttestations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestations [from_nonce]",
+		Short: "query the attestations starting at a nonce",
+		Long: "query the attestations starting at a nonce, or at the earliest available nonce if none is provided. " +
+			"The next_nonce of the output can be used as the from_nonce of the next page.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var fromNonce uint64
+			if len(args) == 1 {
+				var err error
+				fromNonce, err = strconv.ParseUint(args[0], 10, 0)
+				if err != nil {
+					return err
+				}
+			}
+			limit, err := cmd.Flags().GetUint64(FlagLimit)
+			if err != nil {
+				return err
+			}
+			typeFlag, err := cmd.Flags().GetString(FlagType)
+			if err != nil {
+				return err
+			}
+			typeFilter, ok := attestationTypes[typeFlag]
+			if !ok {
+				return fmt.Errorf("invalid attestation type %q, must be valset or data-commitment", typeFlag)
+			}
+
+			res, err := queryClient.Attestations(
+				cmd.Context(),
+				&types.QueryAttestationsRequest{FromNonce: fromNonce, Limit: limit, TypeFilter: typeFilter},
+			)
+			if err != nil {
+				return err
+			}
+			attestations := make([]types.AttestationRequestI, len(res.Attestations))
+			for i, attestation := range res.Attestations {
+				attestations[i], err = unmarshallAttestation(attestation)
+				if err != nil {
+					return err
+				}
+			}
+
+			jsonAttestations, err := json.Marshal(struct {
+				Attestations []types.AttestationRequestI `json:"attestations"`
+				NextNonce    uint64                      `json:"next_nonce"`
+			}{attestations, res.NextNonce})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(string(jsonAttestations))
+		},
+	}
+
+	cmd.Flags().Uint64(FlagLimit, 0, fmt.Sprintf("maximum number of attestations to return (default %d, at most %d)", types.DefaultAttestationsQueryLimit, types.MaxAttestationsQueryLimit))
+	cmd.Flags().String(FlagType, "", "only return attestations of this type: valset or data-commitment")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryValsetsInRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "valsets <begin_nonce> <end_nonce>",
+		Short: "query the valsets with a nonce between begin_nonce and end_nonce, both inclusive",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			beginNonce, err := strconv.ParseUint(args[0], 10, 0)
+			if err != nil {
+				return err
+			}
+			endNonce, err := strconv.ParseUint(args[1], 10, 0)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.ValsetsInRange(
+				cmd.Context(),
+				&types.QueryValsetsInRangeRequest{BeginNonce: beginNonce, EndNonce: endNonce},
+			)
+			if err != nil {
+				return err
+			}
+			jsonValsets, err := json.Marshal(res.Valsets)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(string(jsonValsets))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdQueryEVMAddress() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "evm <validator_valoper_address>",
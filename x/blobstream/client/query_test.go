@@ -55,3 +55,59 @@ func (s *CLITestSuite) TestQueryAttestationByNonce() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestQueryAttestations() {
+	_, err := s.cctx.WaitForHeightWithTimeout(402, 2*time.Minute)
+	s.Require().NoError(err)
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			name:      "query from the earliest nonce",
+			args:      []string{},
+			expectErr: false,
+		},
+		{
+			name:      "query valsets from the first nonce",
+			args:      []string{"1", "--type=valset", "--limit=2"},
+			expectErr: false,
+		},
+		{
+			name:      "invalid attestation type",
+			args:      []string{"1", "--type=unknown"},
+			expectErr: true,
+		},
+		{
+			name:      "higher attestation nonce than latest attestation nonce",
+			args:      []string{"100"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(_ *testing.T) {
+			cmd := client.CmdQueryAttestations()
+			_, err := clitestutil.ExecTestCLICmd(s.cctx.Context, cmd, tc.args)
+			if tc.expectErr {
+				s.Assert().Error(err)
+			} else {
+				s.Assert().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestQueryValsetsInRange() {
+	_, err := s.cctx.WaitForHeightWithTimeout(402, 2*time.Minute)
+	s.Require().NoError(err)
+
+	cmd := client.CmdQueryValsetsInRange()
+	_, err = clitestutil.ExecTestCLICmd(s.cctx.Context, cmd, []string{"1", "2"})
+	s.Assert().NoError(err)
+
+	cmd = client.CmdQueryValsetsInRange()
+	_, err = clitestutil.ExecTestCLICmd(s.cctx.Context, cmd, []string{"2", "1"})
+	s.Assert().Error(err)
+}
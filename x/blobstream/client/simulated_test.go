@@ -0,0 +1,63 @@
+// The go-ethereum simulated backend depends on github.com/fjl/memsize which
+// doesn't link with go1.23 and later.
+//go:build !go1.23
+
+package client_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/test/util/evm"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/client"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/rpc/client/http"
+)
+
+func TestVerifyProofBundleSimulatedBackend(t *testing.T) {
+	bundle, dataRootTupleRoot := newProofBundle(t)
+
+	simulated := evm.NewSimulatedBlobstream(t)
+	otherNonce := simulated.SubmitDataRootTupleRoot(t, tmrand.Bytes(32))
+	bundle.Nonce = simulated.SubmitDataRootTupleRoot(t, dataRootTupleRoot)
+
+	testVerifyProofBundle(t, simulated, bundle, otherNonce)
+}
+
+// TestVerifySharesWithSimulatedBackend relays the data commitment of a block to
+// a Blobstream contract on the simulated backend and verifies that shares of
+// the block were committed to by calling the contract.
+func (s *CLITestSuite) TestVerifySharesWithSimulatedBackend() {
+	_, err := s.cctx.WaitForHeightWithTimeout(402, 2*time.Minute)
+	s.Require().NoError(err)
+
+	const height = 10
+	ctx := s.cctx.GoContext()
+	resp, err := types.NewQueryClient(s.cctx.GRPCClient).DataCommitmentRangeForHeight(ctx, &types.QueryDataCommitmentRangeForHeightRequest{Height: height})
+	s.Require().NoError(err)
+	trpc, err := http.New(s.rpcAddr, "/websocket")
+	s.Require().NoError(err)
+	dataCommitment, err := trpc.DataCommitment(ctx, resp.DataCommitment.BeginBlock, resp.DataCommitment.EndBlock)
+	s.Require().NoError(err)
+
+	simulated := evm.NewSimulatedBlobstream(s.T())
+	config := client.VerifyConfig{TendermintRPC: s.rpcAddr, CelesGRPC: s.grpcAddr}
+
+	// the contract only accepts attestations in nonce order
+	for simulated.Nonce()+1 < resp.DataCommitment.Nonce {
+		simulated.SubmitDataRootTupleRoot(s.T(), tmrand.Bytes(32))
+	}
+	s.Require().Equal(resp.DataCommitment.Nonce, simulated.Nonce()+1)
+
+	isCommittedTo, err := client.VerifySharesWith(ctx, tmlog.NewNopLogger(), &bytes.Buffer{}, config, simulated, height, 0, 1)
+	s.Require().NoError(err)
+	s.Assert().False(isCommittedTo)
+
+	simulated.SubmitDataRootTupleRoot(s.T(), dataCommitment.DataCommitment)
+	isCommittedTo, err = client.VerifySharesWith(ctx, tmlog.NewNopLogger(), &bytes.Buffer{}, config, simulated, height, 0, 1)
+	s.Require().NoError(err)
+	s.Assert().True(isCommittedTo)
+}
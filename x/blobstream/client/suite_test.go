@@ -11,8 +11,10 @@ import (
 
 type CLITestSuite struct {
 	suite.Suite
-	cfg  *testnode.Config
-	cctx testnode.Context
+	cfg      *testnode.Config
+	cctx     testnode.Context
+	rpcAddr  string
+	grpcAddr string
 }
 
 func (s *CLITestSuite) SetupSuite() {
@@ -30,7 +32,7 @@ func (s *CLITestSuite) SetupSuite() {
 	cfg.WithFundedAccounts(accounts...)
 
 	s.cfg = cfg
-	s.cctx, _, _ = testnode.NewNetwork(s.T(), cfg)
+	s.cctx, s.rpcAddr, s.grpcAddr = testnode.NewNetwork(s.T(), cfg)
 
 	height, err := s.cctx.WaitForHeight(2)
 	s.Require().NoError(err)
@@ -1,7 +1,9 @@
 package client
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -10,6 +12,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// FlagEVMSignature is the flag for the EVM address ownership signature.
+const FlagEVMSignature = "evm-signature"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -33,6 +38,14 @@ func CmdRegisterEVMAddress() *cobra.Command {
 sign attestations as part of the Blobstream protocol. Only the validator, as the signer,
 can register an EVM address. To change the EVM address, the validator can simply
 send a new message overriding the previous one.
+
+Control of the EVM key can be proven by passing the hex encoded EIP-191
+personal_sign signature, by the EVM key, of the following message via
+--evm-signature:
+
+  Register EVM address for Blobstream validator <valAddress> on chain <chainID>
+
+The EVM address is then only registered if the signature is valid.
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -42,15 +55,28 @@ send a new message overriding the previous one.
 			}
 
 			valAddress, evmAddress := args[0], args[1]
+			evmSignatureHex, err := cmd.Flags().GetString(FlagEVMSignature)
+			if err != nil {
+				return err
+			}
+			var evmSignature []byte
+			if evmSignatureHex != "" {
+				evmSignature, err = hex.DecodeString(strings.TrimPrefix(evmSignatureHex, "0x"))
+				if err != nil {
+					return fmt.Errorf("decoding EVM signature: %w", err)
+				}
+			}
 			msg := &types.MsgRegisterEVMAddress{
 				ValidatorAddress: valAddress,
 				EvmAddress:       evmAddress,
+				EvmSignature:     evmSignature,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagEVMSignature, "", "hex encoded EIP-191 signature of the EVM address ownership message by the EVM key")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -4,24 +4,22 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"math"
 	"math/big"
-	"os"
 	"strconv"
 
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/tendermint/tendermint/crypto/merkle"
 
 	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 	square "github.com/celestiaorg/go-square/v2"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/spf13/cobra"
 	tmlog "github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/rpc/client/http"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 func VerifyCmd() *cobra.Command {
@@ -33,6 +31,8 @@ func VerifyCmd() *cobra.Command {
 		txCmd(),
 		sharesCmd(),
 		blobCmd(),
+		exportProofCmd(),
+		checkBundleCmd(),
 	)
 	return command
 }
@@ -53,7 +53,7 @@ func txCmd() *cobra.Command {
 				return err
 			}
 
-			logger := tmlog.NewTMLogger(os.Stdout)
+			logger := newVerifyLogger(cmd, config)
 
 			trpc, err := http.New(config.TendermintRPC, "/websocket")
 			if err != nil {
@@ -91,7 +91,7 @@ func txCmd() *cobra.Command {
 				return err
 			}
 
-			_, err = VerifyShares(cmd.Context(), logger, config, tx.Height, uint64(shareRange.Start), uint64(shareRange.End))
+			_, err = VerifyShares(cmd.Context(), logger, cmd.OutOrStdout(), config, tx.Height, uint64(shareRange.Start), uint64(shareRange.End))
 			return err
 		},
 	}
@@ -123,7 +123,7 @@ func blobCmd() *cobra.Command {
 				return err
 			}
 
-			logger := tmlog.NewTMLogger(os.Stdout)
+			logger := newVerifyLogger(cmd, config)
 
 			trpc, err := http.New(config.TendermintRPC, "/websocket")
 			if err != nil {
@@ -160,7 +160,7 @@ func blobCmd() *cobra.Command {
 				return err
 			}
 
-			_, err = VerifyShares(cmd.Context(), logger, config, tx.Height, uint64(blobShareRange.Start), uint64(blobShareRange.End))
+			_, err = VerifyShares(cmd.Context(), logger, cmd.OutOrStdout(), config, tx.Height, uint64(blobShareRange.Start), uint64(blobShareRange.End))
 			return err
 		},
 	}
@@ -194,31 +194,42 @@ func sharesCmd() *cobra.Command {
 				return err
 			}
 
-			logger := tmlog.NewTMLogger(os.Stdout)
+			logger := newVerifyLogger(cmd, config)
 
-			_, err = VerifyShares(cmd.Context(), logger, config, height, startShare, endShare)
+			_, err = VerifyShares(cmd.Context(), logger, cmd.OutOrStdout(), config, height, startShare, endShare)
 			return err
 		},
 	}
 	return addVerifyFlags(command)
 }
 
-func VerifyShares(ctx context.Context, logger tmlog.Logger, config VerifyConfig, height int64, startShare uint64, endShare uint64) (isCommittedTo bool, err error) {
-	trpc, err := http.New(config.TendermintRPC, "/websocket")
+// VerifyShares verifies that the shares in [startShare, endShare) at the given
+// height were committed to by Blobstream, using the verifier configured by
+// config. The ABI encoded proofs are written to out if config.ABIOutput is set.
+func VerifyShares(ctx context.Context, logger tmlog.Logger, out io.Writer, config VerifyConfig, height int64, startShare uint64, endShare uint64) (isCommittedTo bool, err error) {
+	verifier, closeVerifier, err := newAttestationVerifier(config)
 	if err != nil {
 		return false, err
 	}
-	err = trpc.Start()
-	if err != nil {
-		return false, err
-	}
-	defer func(trpc *http.HTTP) {
-		err := trpc.Stop()
-		if err != nil {
-			logger.Debug("error closing connection", "err", err.Error())
-		}
-	}(trpc)
+	defer closeVerifier()
 
+	return VerifySharesWith(ctx, logger, out, config, verifier, height, startShare, endShare)
+}
+
+// VerifySharesWith verifies that the shares in [startShare, endShare) at the
+// given height were committed to by Blobstream, using verifier to look up the
+// relayed data commitments. The ABI encoded proofs are written to out, as a hex
+// string on a line, if config.ABIOutput is set.
+func VerifySharesWith(
+	ctx context.Context,
+	logger tmlog.Logger,
+	out io.Writer,
+	config VerifyConfig,
+	verifier AttestationVerifier,
+	height int64,
+	startShare uint64,
+	endShare uint64,
+) (isCommittedTo bool, err error) {
 	logger.Info(
 		"proving shares inclusion to data root",
 		"height",
@@ -229,95 +240,72 @@ func VerifyShares(ctx context.Context, logger tmlog.Logger, config VerifyConfig,
 		endShare,
 	)
 
-	if height < 0 {
-		return false, fmt.Errorf("height must be a positive integer")
-	}
-
-	unsignedHeight := uint64(height)
-	logger.Debug("getting shares proof from tendermint node")
-	sharesProofs, err := trpc.ProveShares(ctx, unsignedHeight, startShare, endShare)
+	bundle, err := ExportProofBundle(ctx, logger, config.TendermintRPC, config.CelesGRPC, height, startShare, endShare)
 	if err != nil {
 		return false, err
 	}
 
+	if config.ABIOutput != "" {
+		bz, err := EncodeABIOutput(
+			config.ABIOutput,
+			*bundle.ShareProof,
+			bundle.Nonce,
+			uint64(bundle.Height),
+			bundle.DataRoot,
+			bundle.DataRootTupleProof,
+		)
+		if err != nil {
+			return false, err
+		}
+		if _, err := fmt.Fprintln(out, hexutil.Encode(bz)); err != nil {
+			return false, err
+		}
+	}
+
+	return VerifyProofBundle(ctx, logger, verifier, bundle)
+}
+
+// VerifyProofBundle verifies that the shares in the bundle are included in the
+// bundle's data root and that the data root was committed to by the data
+// commitment with the bundle's nonce, as reported by verifier.
+func VerifyProofBundle(ctx context.Context, logger tmlog.Logger, verifier AttestationVerifier, bundle proof.ProofBundle) (isCommittedTo bool, err error) {
+	if bundle.ShareProof == nil || bundle.DataRootTupleProof == nil {
+		return false, fmt.Errorf("incomplete proof bundle")
+	}
+
 	logger.Debug("verifying shares proofs")
 	// checks if the shares proof is valid.
 	// the shares proof is self verifiable because it contains also the rows roots
 	// which the nmt shares proof is verified against.
-	if !sharesProofs.VerifyProof() {
-		logger.Info("proofs from shares to data root are invalid")
-		return false, err
+	if err := bundle.ShareProof.Validate(bundle.DataRoot); err != nil {
+		logger.Info("proofs from shares to data root are invalid", "err", err.Error())
+		return false, nil
 	}
 
 	logger.Info("proofs from shares to data root are valid")
 
-	bsGRPC, err := grpc.NewClient(config.CelesGRPC, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		return false, err
-	}
-	defer func(bsGRPC *grpc.ClientConn) {
-		err := bsGRPC.Close()
-		if err != nil {
-			logger.Debug("error closing connection", "err", err.Error())
-		}
-	}(bsGRPC)
-
-	queryClient := types.NewQueryClient(bsGRPC)
-
-	resp, err := queryClient.DataCommitmentRangeForHeight(
-		ctx,
-		&types.QueryDataCommitmentRangeForHeightRequest{Height: unsignedHeight},
-	)
-	if err != nil {
-		return false, err
-	}
-
 	logger.Info(
 		"proving that the data root was committed to in the Blobstream contract",
-		"contract_address",
-		config.ContractAddr,
 		"fist_block",
-		resp.DataCommitment.BeginBlock,
+		bundle.BeginBlock,
 		"last_block",
-		resp.DataCommitment.EndBlock,
+		bundle.EndBlock,
 		"nonce",
-		resp.DataCommitment.Nonce,
+		bundle.Nonce,
 	)
 
-	logger.Debug("getting the data root to commitment inclusion proof")
-	dcProof, err := trpc.DataRootInclusionProof(ctx, unsignedHeight, resp.DataCommitment.BeginBlock, resp.DataCommitment.EndBlock)
-	if err != nil {
-		return false, err
-	}
-
-	block, err := trpc.Block(ctx, &height)
-	if err != nil {
-		return false, err
-	}
-
-	ethClient, err := ethclient.Dial(config.EVMRPC)
-	if err != nil {
-		return false, err
-	}
-	defer ethClient.Close()
-
-	bsWrapper, err := wrapper.NewWrappers(config.ContractAddr, ethClient)
-	if err != nil {
-		return false, err
-	}
-
 	logger.Info("verifying that the data root was committed to in the Blobstream contract")
 	isCommittedTo, err = VerifyDataRootInclusion(
 		ctx,
-		bsWrapper,
-		resp.DataCommitment.Nonce,
-		height,
-		block.Block.DataHash,
+		verifier,
+		bundle.Nonce,
+		bundle.Height,
+		bundle.DataRoot,
 		merkle.Proof{
-			Total:    dcProof.Proof.Total,
-			Index:    dcProof.Proof.Index,
-			LeafHash: dcProof.Proof.LeafHash,
-			Aunts:    dcProof.Proof.Aunts,
+			Total:    bundle.DataRootTupleProof.Total,
+			Index:    bundle.DataRootTupleProof.Index,
+			LeafHash: bundle.DataRootTupleProof.LeafHash,
+			Aunts:    bundle.DataRootTupleProof.Aunts,
 		},
 	)
 	if err != nil {
@@ -334,13 +322,16 @@ func VerifyShares(ctx context.Context, logger tmlog.Logger, config VerifyConfig,
 }
 
 func VerifyDataRootInclusion(
-	_ context.Context,
-	bsWrapper *wrapper.Wrappers,
+	ctx context.Context,
+	verifier AttestationVerifier,
 	nonce uint64,
 	height int64,
 	dataRoot []byte,
 	proof merkle.Proof,
 ) (bool, error) {
+	if len(dataRoot) != 32 {
+		return false, fmt.Errorf("data root must be 32 bytes, got %d", len(dataRoot))
+	}
 	tuple := wrapper.DataRootTuple{
 		Height:   big.NewInt(height),
 		DataRoot: *(*[32]byte)(dataRoot),
@@ -356,18 +347,85 @@ func VerifyDataRootInclusion(
 		NumLeaves: big.NewInt(proof.Total),
 	}
 
-	valid, err := bsWrapper.VerifyAttestation(
-		&bind.CallOpts{},
-		big.NewInt(int64(nonce)),
-		tuple,
-		wrappedProof,
-	)
+	valid, err := verifier.VerifyAttestation(ctx, nonce, tuple, wrappedProof)
 	if err != nil {
 		return false, err
 	}
 	return valid, nil
 }
 
+// newAttestationVerifier returns the file verifier if a relayed commitments
+// file was provided, and a verifier calling the Blobstream contract over the
+// EVM RPC otherwise.
+func newAttestationVerifier(config VerifyConfig) (AttestationVerifier, func(), error) {
+	if config.CommitmentsFile != "" {
+		verifier, err := LoadFileVerifier(config.CommitmentsFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		return verifier, func() {}, nil
+	}
+	verifier, err := DialContractVerifier(config.EVMRPC, config.ContractAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return verifier, verifier.Close, nil
+}
+
+// EncodeABIOutput returns the Solidity ABI encoding of the proofs that the
+// shares were committed to by the data commitment with the given nonce, using
+// the given ABI output mode.
+func EncodeABIOutput(
+	mode string,
+	sharesProof proof.ShareProof,
+	nonce uint64,
+	height uint64,
+	dataRoot []byte,
+	dataRootTupleProof *proof.Proof,
+) ([]byte, error) {
+	switch mode {
+	case ABIOutputSharesProof:
+		p, err := types.NewABISharesProof(sharesProof, nonce, height, dataRoot, dataRootTupleProof)
+		if err != nil {
+			return nil, err
+		}
+		return types.EncodeSharesProof(p)
+	case ABIOutputDataRootTuple:
+		tuple, err := types.NewABIDataRootTuple(height, dataRoot)
+		if err != nil {
+			return nil, err
+		}
+		return types.EncodeDataRootTuple(tuple)
+	case ABIOutputBinaryMerkleProof:
+		p, err := types.NewABIBinaryMerkleProof(dataRootTupleProof)
+		if err != nil {
+			return nil, err
+		}
+		return types.EncodeBinaryMerkleProof(p)
+	case ABIOutputVerifyAttestation:
+		tuple, err := types.NewABIDataRootTuple(height, dataRoot)
+		if err != nil {
+			return nil, err
+		}
+		p, err := types.NewABIBinaryMerkleProof(dataRootTupleProof)
+		if err != nil {
+			return nil, err
+		}
+		return types.EncodeVerifyAttestationCalldata(nonce, tuple, p)
+	default:
+		return nil, fmt.Errorf("unsupported ABI output %q", mode)
+	}
+}
+
+// newVerifyLogger returns the logger used by the verify commands. The logs are
+// written to stderr when the proofs are printed to stdout.
+func newVerifyLogger(cmd *cobra.Command, config VerifyConfig) tmlog.Logger {
+	if config.ABIOutput != "" {
+		return tmlog.NewTMLogger(cmd.ErrOrStderr())
+	}
+	return tmlog.NewTMLogger(cmd.OutOrStdout())
+}
+
 func safeConvertInt64ToInt(x int64) (int, error) {
 	if x < math.MinInt {
 		return 0, fmt.Errorf("value %d is too small to be converted to int", x)
@@ -0,0 +1,58 @@
+package client_test
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/client"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/rpc/client/http"
+)
+
+func (s *CLITestSuite) TestVerifySharesWith() {
+	_, err := s.cctx.WaitForHeightWithTimeout(402, 2*time.Minute)
+	s.Require().NoError(err)
+
+	const height = 10
+	ctx := s.cctx.GoContext()
+	resp, err := types.NewQueryClient(s.cctx.GRPCClient).DataCommitmentRangeForHeight(ctx, &types.QueryDataCommitmentRangeForHeightRequest{Height: height})
+	s.Require().NoError(err)
+	trpc, err := http.New(s.rpcAddr, "/websocket")
+	s.Require().NoError(err)
+	dataCommitment, err := trpc.DataCommitment(ctx, resp.DataCommitment.BeginBlock, resp.DataCommitment.EndBlock)
+	s.Require().NoError(err)
+
+	relayed := client.RelayedCommitment{Nonce: resp.DataCommitment.Nonce, DataRootTupleRoot: []byte(dataCommitment.DataCommitment)}
+	other := client.RelayedCommitment{Nonce: resp.DataCommitment.Nonce, DataRootTupleRoot: tmrand.Bytes(32)}
+	config := client.VerifyConfig{
+		TendermintRPC: s.rpcAddr,
+		CelesGRPC:     s.grpcAddr,
+		ABIOutput:     client.ABIOutputSharesProof,
+	}
+
+	for name, verifier := range newVerifiers(s.T(), relayed) {
+		s.Run(name, func() {
+			var out bytes.Buffer
+			isCommittedTo, err := client.VerifySharesWith(ctx, tmlog.NewNopLogger(), &out, config, verifier, height, 0, 1)
+			s.Require().NoError(err)
+			s.Assert().True(isCommittedTo)
+
+			// the ABI encoded shares proof is written to out
+			encoded, err := hexutil.Decode(strings.TrimSpace(out.String()))
+			s.Require().NoError(err)
+			s.Assert().NotEmpty(encoded)
+		})
+	}
+
+	for name, verifier := range newVerifiers(s.T(), other) {
+		s.Run(name+" not relayed", func() {
+			isCommittedTo, err := client.VerifySharesWith(ctx, tmlog.NewNopLogger(), &bytes.Buffer{}, client.VerifyConfig{TendermintRPC: s.rpcAddr, CelesGRPC: s.grpcAddr}, verifier, height, 0, 1)
+			s.Require().NoError(err)
+			s.Assert().False(isCommittedTo)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -35,15 +36,43 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace p
 	}
 }
 
-// GetParams returns the parameters from the store
+// GetParams returns the parameters from the store. The attestation expiry time
+// and significant power difference threshold default to
+// types.DefaultAttestationExpiryTime and
+// types.DefaultSignificantPowerDifferenceThreshold if they were never set.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
-	return params
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyDataCommitmentWindow, &params.DataCommitmentWindow)
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyAttestationExpiryTime, &params.AttestationExpiryTime)
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeySignificantPowerDifferenceThreshold, &params.SignificantPowerDifferenceThreshold)
+	return params.WithDefaults()
 }
 
-// SetParams sets the parameters in the store
+// SetParams sets the parameters in the store. The attestation expiry time and
+// significant power difference threshold are only written if they are set so
+// that the state of chains that don't set them is left unchanged.
 func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
-	k.paramSpace.SetParamSet(ctx, &ps)
+	if err := ps.ValidateBasic(); err != nil {
+		panic(fmt.Sprintf("invalid params: %s", err))
+	}
+	k.paramSpace.Set(ctx, types.ParamsStoreKeyDataCommitmentWindow, ps.DataCommitmentWindow)
+	if ps.AttestationExpiryTime != 0 {
+		k.paramSpace.Set(ctx, types.ParamsStoreKeyAttestationExpiryTime, ps.AttestationExpiryTime)
+	}
+	if !ps.SignificantPowerDifferenceThreshold.IsNil() && !ps.SignificantPowerDifferenceThreshold.IsZero() {
+		k.paramSpace.Set(ctx, types.ParamsStoreKeySignificantPowerDifferenceThreshold, ps.SignificantPowerDifferenceThreshold)
+	}
+}
+
+// GetAttestationExpiryTimeParam returns the time after which an attestation is
+// pruned from state.
+func (k Keeper) GetAttestationExpiryTimeParam(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).AttestationExpiryTime
+}
+
+// GetSignificantPowerDifferenceThresholdParam returns the threshold of change
+// in the validator set power that triggers the creation of a new valset.
+func (k Keeper) GetSignificantPowerDifferenceThresholdParam(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).SignificantPowerDifferenceThreshold
 }
 
 // DeserializeValidatorIterator returns validators from the validator iterator.
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/errors"
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -137,3 +138,67 @@ func (k Keeper) DeleteAttestation(ctx sdk.Context, nonce uint64) {
 	}
 	store.Delete(key)
 }
+
+// GetAttestations returns up to limit attestations of the provided type,
+// starting at fromNonce, in increasing nonce order. If fromNonce is zero, the
+// attestations start at the earliest available nonce. At most
+// types.MaxAttestationsQueryLimit nonces are read. The returned next nonce is
+// the nonce to continue from, or zero if the latest attestation was reached.
+func (k Keeper) GetAttestations(
+	ctx sdk.Context,
+	fromNonce uint64,
+	limit uint64,
+	filter types.AttestationType,
+) ([]types.AttestationRequestI, uint64, error) {
+	if _, ok := types.AttestationType_name[int32(filter)]; !ok {
+		return nil, 0, errors.Wrap(types.ErrUnknownAttestationType, filter.String())
+	}
+	if !k.CheckLatestAttestationNonce(ctx) {
+		return nil, 0, types.ErrLatestAttestationNonceStillNotInitialized
+	}
+	if !k.CheckEarliestAvailableAttestationNonce(ctx) {
+		return nil, 0, types.ErrEarliestAvailableNonceStillNotInitialized
+	}
+	earliestAvailableNonce := k.GetEarliestAvailableAttestationNonce(ctx)
+	latestNonce := k.GetLatestAttestationNonce(ctx)
+	if fromNonce == 0 {
+		fromNonce = earliestAvailableNonce
+	}
+	if fromNonce < earliestAvailableNonce {
+		return nil, 0, types.ErrRequestedNonceWasPruned
+	}
+	if fromNonce > latestNonce {
+		return nil, 0, types.ErrNonceHigherThanLatestAttestationNonce
+	}
+	if limit == 0 {
+		limit = types.DefaultAttestationsQueryLimit
+	}
+	if limit > types.MaxAttestationsQueryLimit {
+		limit = types.MaxAttestationsQueryLimit
+	}
+
+	attestations := make([]types.AttestationRequestI, 0, limit)
+	nonce := fromNonce
+	for ; nonce <= latestNonce && nonce-fromNonce < types.MaxAttestationsQueryLimit; nonce++ {
+		if uint64(len(attestations)) == limit {
+			break
+		}
+		at, found, err := k.GetAttestationByNonce(ctx, nonce)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !found {
+			return nil, 0, errors.Wrap(
+				types.ErrNilAttestation,
+				fmt.Sprintf("nonce=%d", nonce),
+			)
+		}
+		if filter.Matches(at) {
+			attestations = append(attestations, at)
+		}
+	}
+	if nonce > latestNonce {
+		return attestations, 0, nil
+	}
+	return attestations, nonce, nil
+}
@@ -5,7 +5,12 @@ import (
 
 	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestCheckLatestAttestationNonce(t *testing.T) {
@@ -39,3 +44,95 @@ func TestCheckEarliestAvailableAttestationNonce(t *testing.T) {
 	input.BlobstreamKeeper.CheckEarliestAvailableAttestationNonce(input.Context)
 	assert.Equal(t, blobstream.InitialEarliestAvailableAttestationNonce, input.BlobstreamKeeper.GetEarliestAvailableAttestationNonce(input.Context))
 }
+
+func TestGetAttestations(t *testing.T) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	k.SetEarliestAvailableAttestationNonce(sdkCtx, blobstream.InitialEarliestAvailableAttestationNonce)
+
+	// nonce 1 is a valset, nonces 2 to 9 are data commitments and nonce 10 is
+	// a valset.
+	valset, err := k.GetCurrentValset(sdkCtx)
+	require.NoError(t, err)
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &valset))
+	for nonce := uint64(2); nonce < 10; nonce++ {
+		dc := types.NewDataCommitment(nonce, nonce*10, (nonce+1)*10, sdkCtx.BlockTime())
+		require.NoError(t, k.SetAttestationRequest(sdkCtx, dc))
+	}
+	secondValset := valset
+	secondValset.Nonce = 10
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &secondValset))
+
+	tests := []struct {
+		name           string
+		fromNonce      uint64
+		limit          uint64
+		filter         types.AttestationType
+		expectedNonces []uint64
+		expectedNext   uint64
+		expectedError  error
+	}{
+		{
+			name:           "all attestations from the earliest nonce",
+			expectedNonces: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		},
+		{
+			name:           "first page",
+			fromNonce:      1,
+			limit:          4,
+			expectedNonces: []uint64{1, 2, 3, 4},
+			expectedNext:   5,
+		},
+		{
+			name:           "last page",
+			fromNonce:      8,
+			limit:          4,
+			expectedNonces: []uint64{8, 9, 10},
+		},
+		{
+			name:           "valsets",
+			filter:         types.AttestationTypeValset,
+			expectedNonces: []uint64{1, 10},
+		},
+		{
+			name:           "data commitments",
+			fromNonce:      5,
+			limit:          2,
+			filter:         types.AttestationTypeDataCommitment,
+			expectedNonces: []uint64{5, 6},
+			expectedNext:   7,
+		},
+		{
+			name:          "nonce higher than the latest nonce",
+			fromNonce:     11,
+			expectedError: types.ErrNonceHigherThanLatestAttestationNonce,
+		},
+		{
+			name:          "unknown type",
+			filter:        types.AttestationType(3),
+			expectedError: types.ErrUnknownAttestationType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attestations, next, err := k.GetAttestations(sdkCtx, tt.fromNonce, tt.limit, tt.filter)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			nonces := make([]uint64, len(attestations))
+			for i, at := range attestations {
+				nonces[i] = at.GetNonce()
+			}
+			assert.Equal(t, tt.expectedNonces, nonces)
+			assert.Equal(t, tt.expectedNext, next)
+		})
+	}
+}
+
+func TestQueryAttestationsEmptyRequest(t *testing.T) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	_, err := input.BlobstreamKeeper.Attestations(sdk.WrapSDKContext(sdkCtx), nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
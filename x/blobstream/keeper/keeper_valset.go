@@ -160,6 +160,53 @@ func normalizeValidatorPower(rawPower uint64, totalValidatorPower cosmosmath.Int
 	return power.Uint64()
 }
 
+// GetValsetsInRange returns the valsets with a nonce between beginNonce and
+// endNonce, both inclusive. The range can't span more than
+// types.MaxAttestationsQueryLimit nonces.
+func (k Keeper) GetValsetsInRange(ctx sdk.Context, beginNonce, endNonce uint64) ([]types.Valset, error) {
+	if beginNonce > endNonce {
+		return nil, errors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("begin nonce %d is higher than end nonce %d", beginNonce, endNonce),
+		)
+	}
+	if endNonce-beginNonce >= types.MaxAttestationsQueryLimit {
+		return nil, errors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("nonce range can't span more than %d nonces", types.MaxAttestationsQueryLimit),
+		)
+	}
+	if !k.CheckLatestAttestationNonce(ctx) {
+		return nil, types.ErrLatestAttestationNonceStillNotInitialized
+	}
+	if !k.CheckEarliestAvailableAttestationNonce(ctx) {
+		return nil, types.ErrEarliestAvailableNonceStillNotInitialized
+	}
+	if beginNonce < k.GetEarliestAvailableAttestationNonce(ctx) {
+		return nil, types.ErrRequestedNonceWasPruned
+	}
+	if endNonce > k.GetLatestAttestationNonce(ctx) {
+		return nil, types.ErrNonceHigherThanLatestAttestationNonce
+	}
+	valsets := make([]types.Valset, 0)
+	for i := beginNonce; i <= endNonce; i++ {
+		at, found, err := k.GetAttestationByNonce(ctx, i)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, errors.Wrap(
+				types.ErrNilAttestation,
+				fmt.Sprintf("nonce=%d", i),
+			)
+		}
+		if valset, ok := at.(*types.Valset); ok {
+			valsets = append(valsets, *valset)
+		}
+	}
+	return valsets, nil
+}
+
 // GetLatestValsetBeforeNonce returns the previous valset before the provided
 // `nonce`. the `nonce` can be a valset, but this method will return the valset
 // before it. If the provided nonce is 1, it will return an error, because,
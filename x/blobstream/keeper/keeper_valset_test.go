@@ -9,11 +9,14 @@ import (
 
 	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Test that valset creation produces the expected normalized power values.
@@ -225,3 +228,41 @@ func TestEVMAddresses(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "", resp.EvmAddress)
 }
+
+func TestGetValsetsInRange(t *testing.T) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	k.SetEarliestAvailableAttestationNonce(sdkCtx, blobstream.InitialEarliestAvailableAttestationNonce)
+
+	// nonces 1 and 4 are valsets, nonces 2 and 3 are data commitments.
+	valset, err := k.GetCurrentValset(sdkCtx)
+	require.NoError(t, err)
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &valset))
+	for nonce := uint64(2); nonce < 4; nonce++ {
+		dc := types.NewDataCommitment(nonce, nonce*10, (nonce+1)*10, sdkCtx.BlockTime())
+		require.NoError(t, k.SetAttestationRequest(sdkCtx, dc))
+	}
+	secondValset := valset
+	secondValset.Nonce = 4
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &secondValset))
+
+	valsets, err := k.GetValsetsInRange(sdkCtx, 1, 4)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Valset{valset, secondValset}, valsets)
+
+	valsets, err = k.GetValsetsInRange(sdkCtx, 2, 3)
+	require.NoError(t, err)
+	assert.Empty(t, valsets)
+
+	_, err = k.GetValsetsInRange(sdkCtx, 3, 2)
+	assert.Error(t, err)
+
+	_, err = k.GetValsetsInRange(sdkCtx, 1, 5)
+	assert.ErrorIs(t, err, types.ErrNonceHigherThanLatestAttestationNonce)
+
+	_, err = k.GetValsetsInRange(sdkCtx, 1, types.MaxAttestationsQueryLimit+1)
+	assert.Error(t, err)
+
+	_, err = k.ValsetsInRange(sdk.WrapSDKContext(sdkCtx), nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
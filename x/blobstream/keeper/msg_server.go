@@ -23,7 +23,8 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 }
 
 // RegisterEVMAddress verifies that the validator exists on chain. It then stores the EVM address.
-// If it already exists, it will simply overwrite the previous value
+// If it already exists, it will simply overwrite the previous value. If an EVM signature is
+// provided, the EVM address is only stored if the signature proves ownership of the EVM key.
 func (k Keeper) RegisterEVMAddress(goCtx context.Context, msg *types.MsgRegisterEVMAddress) (*types.MsgRegisterEVMAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -38,6 +39,12 @@ func (k Keeper) RegisterEVMAddress(goCtx context.Context, msg *types.MsgRegister
 		return nil, staking.ErrNoValidatorFound
 	}
 
+	if len(msg.EvmSignature) != 0 {
+		if err := types.VerifyEVMAddressOwnership(evmAddr, valAddr.String(), ctx.ChainID(), msg.EvmSignature); err != nil {
+			return nil, err
+		}
+	}
+
 	if !k.IsEVMAddressUnique(ctx, evmAddr) {
 		return nil, errors.Wrapf(types.ErrEVMAddressAlreadyExists, "address %s", msg.EvmAddress)
 	}
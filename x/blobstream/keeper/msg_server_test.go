@@ -7,6 +7,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -36,3 +37,38 @@ func TestRegisterEVMAddress(t *testing.T) {
 	addr, _ := k.GetEVMAddress(sdkCtx, val.GetOperator())
 	require.Equal(t, evmAddr, addr)
 }
+
+func TestRegisterEVMAddressWithSignature(t *testing.T) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	val := input.StakingKeeper.GetValidators(sdkCtx, 100)[0]
+	sdkCtx = sdkCtx.WithChainID("test-chain")
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	evmAddr := crypto.PubkeyToAddress(key.PublicKey)
+
+	// a signature for another chain is rejected
+	signature, err := types.SignEVMAddressOwnership(key, val.GetOperator().String(), "other-chain")
+	require.NoError(t, err)
+	msg := types.NewMsgRegisterEVMAddress(val.GetOperator(), evmAddr)
+	msg.EvmSignature = signature
+	_, err = k.RegisterEVMAddress(sdkCtx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidEVMAddressSignature)
+
+	// a signature for a mistyped EVM address is rejected
+	msg = types.NewMsgRegisterEVMAddress(val.GetOperator(), common.BytesToAddress([]byte("evm_address")))
+	msg.EvmSignature, err = types.SignEVMAddressOwnership(key, val.GetOperator().String(), sdkCtx.ChainID())
+	require.NoError(t, err)
+	_, err = k.RegisterEVMAddress(sdkCtx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidEVMAddressSignature)
+
+	msg = types.NewMsgRegisterEVMAddress(val.GetOperator(), evmAddr)
+	msg.EvmSignature, err = types.SignEVMAddressOwnership(key, val.GetOperator().String(), sdkCtx.ChainID())
+	require.NoError(t, err)
+	_, err = k.RegisterEVMAddress(sdkCtx, msg)
+	require.NoError(t, err)
+
+	addr, _ := k.GetEVMAddress(sdkCtx, val.GetOperator())
+	require.Equal(t, evmAddr, addr)
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (k Keeper) AttestationRequestByNonce(
@@ -37,6 +39,35 @@ func (k Keeper) AttestationRequestByNonce(
 	}, nil
 }
 
+func (k Keeper) Attestations(
+	ctx context.Context,
+	request *types.QueryAttestationsRequest,
+) (*types.QueryAttestationsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	attestations, nextNonce, err := k.GetAttestations(
+		sdk.UnwrapSDKContext(ctx),
+		request.FromNonce,
+		request.Limit,
+		request.TypeFilter,
+	)
+	if err != nil {
+		return nil, err
+	}
+	anys := make([]*codectypes.Any, len(attestations))
+	for i, attestation := range attestations {
+		anys[i], err = codectypes.NewAnyWithValue(attestation)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &types.QueryAttestationsResponse{
+		Attestations: anys,
+		NextNonce:    nextNonce,
+	}, nil
+}
+
 func (k Keeper) LatestAttestationNonce(
 	ctx context.Context,
 	_ *types.QueryLatestAttestationNonceRequest,
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NodeClient is the subset of the consensus node RPC used to compute the data
+// root tuple roots.
+type NodeClient interface {
+	Header(ctx context.Context, height *int64) (*coretypes.ResultHeader, error)
+	DataCommitment(ctx context.Context, start, end uint64) (*coretypes.ResultDataCommitment, error)
+	DataRootInclusionProof(ctx context.Context, height uint64, start, end uint64) (*coretypes.ResultDataRootInclusionProof, error)
+}
+
+// RegisterDataCommitmentQueryService registers the DataCommitmentQuery service
+// on the gRPC router. The data roots are read from the node of clientCtx.
+func RegisterDataCommitmentQueryService(qrt gogogrpc.Server, k Keeper, clientCtx client.Context) {
+	types.RegisterDataCommitmentQueryServer(qrt, NewDataCommitmentQueryServer(k, func() (NodeClient, error) {
+		return clientCtx.GetNode()
+	}))
+}
+
+var _ types.DataCommitmentQueryServer = dataCommitmentQueryServer{}
+
+type dataCommitmentQueryServer struct {
+	keeper Keeper
+	node   func() (NodeClient, error)
+}
+
+// NewDataCommitmentQueryServer returns a DataCommitmentQueryServer that reads
+// the data commitments from the keeper and the data roots from node.
+func NewDataCommitmentQueryServer(k Keeper, node func() (NodeClient, error)) types.DataCommitmentQueryServer {
+	return dataCommitmentQueryServer{keeper: k, node: node}
+}
+
+// DataCommitmentRoot implements the Query/DataCommitmentRoot gRPC method.
+func (s dataCommitmentQueryServer) DataCommitmentRoot(
+	c context.Context,
+	request *types.QueryDataCommitmentRootRequest,
+) (*types.QueryDataCommitmentRootResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !s.keeper.CheckLatestAttestationNonce(ctx) {
+		return nil, types.ErrLatestAttestationNonceStillNotInitialized
+	}
+	if latestAttestationNonce := s.keeper.GetLatestAttestationNonce(ctx); latestAttestationNonce < request.Nonce {
+		return nil, types.ErrNonceHigherThanLatestAttestationNonce
+	}
+
+	attestation, found, err := s.keeper.GetAttestationByNonce(ctx, request.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrAttestationNotFound
+	}
+	dataCommitment, ok := attestation.(*types.DataCommitment)
+	if !ok {
+		return nil, types.ErrAttestationNotDataCommitment
+	}
+
+	node, err := s.node()
+	if err != nil {
+		return nil, err
+	}
+	root, err := node.DataCommitment(c, dataCommitment.BeginBlock, dataCommitment.EndBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDataCommitmentRootResponse{
+		DataCommitment:    dataCommitment,
+		DataRootTupleRoot: root.DataCommitment,
+	}, nil
+}
+
+// DataRootTupleInclusionProof implements the Query/DataRootTupleInclusionProof
+// gRPC method.
+func (s dataCommitmentQueryServer) DataRootTupleInclusionProof(
+	c context.Context,
+	request *types.QueryDataRootTupleInclusionProofRequest,
+) (*types.QueryDataRootTupleInclusionProofResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	dataCommitment, err := s.keeper.GetDataCommitmentForHeight(sdk.UnwrapSDKContext(c), request.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := s.node()
+	if err != nil {
+		return nil, err
+	}
+	height := int64(request.Height)
+	header, err := node.Header(c, &height)
+	if err != nil {
+		return nil, err
+	}
+	root, err := node.DataCommitment(c, dataCommitment.BeginBlock, dataCommitment.EndBlock)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := node.DataRootInclusionProof(c, request.Height, dataCommitment.BeginBlock, dataCommitment.EndBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDataRootTupleInclusionProofResponse{
+		DataCommitment:    &dataCommitment,
+		DataRoot:          header.Header.DataHash,
+		DataRootTupleRoot: root.DataCommitment,
+		Proof:             *proof.Proof.ToProto(),
+	}, nil
+}
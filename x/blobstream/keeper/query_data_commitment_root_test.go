@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/keeper"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// fakeNode computes the data commitments from random data roots.
+type fakeNode struct {
+	dataRoots map[int64][]byte
+}
+
+var _ keeper.NodeClient = &fakeNode{}
+
+func newFakeNode(end int64) *fakeNode {
+	dataRoots := make(map[int64][]byte, end)
+	for h := int64(0); h < end; h++ {
+		dataRoots[h] = tmrand.Bytes(32)
+	}
+	return &fakeNode{dataRoots: dataRoots}
+}
+
+func (n *fakeNode) tuples(start, end uint64) [][]byte {
+	tuples := make([][]byte, 0, end-start)
+	for h := start; h < end; h++ {
+		tuples = append(tuples, append(make([]byte, 24), n.dataRoots[int64(h)]...))
+	}
+	return tuples
+}
+
+func (n *fakeNode) Header(_ context.Context, height *int64) (*coretypes.ResultHeader, error) {
+	return &coretypes.ResultHeader{Header: &tmtypes.Header{Height: *height, DataHash: n.dataRoots[*height]}}, nil
+}
+
+func (n *fakeNode) DataCommitment(_ context.Context, start, end uint64) (*coretypes.ResultDataCommitment, error) {
+	return &coretypes.ResultDataCommitment{DataCommitment: merkle.HashFromByteSlices(n.tuples(start, end))}, nil
+}
+
+func (n *fakeNode) DataRootInclusionProof(_ context.Context, height uint64, start, end uint64) (*coretypes.ResultDataRootInclusionProof, error) {
+	_, proofs := merkle.ProofsFromByteSlices(n.tuples(start, end))
+	return &coretypes.ResultDataRootInclusionProof{Proof: *proofs[height-start]}, nil
+}
+
+func TestDataCommitmentRoot(t *testing.T) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+
+	initialValset, err := k.GetCurrentValset(sdkCtx)
+	require.NoError(t, err)
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &initialValset))
+
+	dc := types.DataCommitment{Nonce: 2, BeginBlock: 1, EndBlock: 11}
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &dc))
+
+	node := newFakeNode(int64(dc.EndBlock))
+	server := keeper.NewDataCommitmentQueryServer(k, func() (keeper.NodeClient, error) { return node, nil })
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	resp, err := server.DataCommitmentRoot(ctx, &types.QueryDataCommitmentRootRequest{Nonce: dc.Nonce})
+	require.NoError(t, err)
+	assert.Equal(t, &dc, resp.DataCommitment)
+	assert.Equal(t, merkle.HashFromByteSlices(node.tuples(dc.BeginBlock, dc.EndBlock)), resp.DataRootTupleRoot)
+
+	_, err = server.DataCommitmentRoot(ctx, &types.QueryDataCommitmentRootRequest{Nonce: initialValset.Nonce})
+	assert.ErrorIs(t, err, types.ErrAttestationNotDataCommitment)
+
+	_, err = server.DataCommitmentRoot(ctx, &types.QueryDataCommitmentRootRequest{Nonce: dc.Nonce + 1})
+	assert.ErrorIs(t, err, types.ErrNonceHigherThanLatestAttestationNonce)
+}
+
+func TestDataRootTupleInclusionProof(t *testing.T) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+
+	initialValset, err := k.GetCurrentValset(sdkCtx)
+	require.NoError(t, err)
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &initialValset))
+
+	dc := types.DataCommitment{Nonce: 2, BeginBlock: 1, EndBlock: 11}
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &dc))
+
+	node := newFakeNode(int64(dc.EndBlock))
+	server := keeper.NewDataCommitmentQueryServer(k, func() (keeper.NodeClient, error) { return node, nil })
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	const height = 4
+	resp, err := server.DataRootTupleInclusionProof(ctx, &types.QueryDataRootTupleInclusionProofRequest{Height: height})
+	require.NoError(t, err)
+	assert.Equal(t, &dc, resp.DataCommitment)
+	assert.Equal(t, node.dataRoots[height], []byte(resp.DataRoot))
+
+	proof, err := merkle.ProofFromProto(&resp.Proof)
+	require.NoError(t, err)
+	tuple := append(make([]byte, 24), resp.DataRoot...)
+	assert.NoError(t, proof.Verify(resp.DataRootTupleRoot, tuple))
+
+	_, err = server.DataRootTupleInclusionProof(ctx, &types.QueryDataRootTupleInclusionProofRequest{Height: dc.EndBlock + 100})
+	assert.Error(t, err)
+
+	failing := keeper.NewDataCommitmentQueryServer(k, func() (keeper.NodeClient, error) { return nil, errors.New("no node") })
+	_, err = failing.DataRootTupleInclusionProof(ctx, &types.QueryDataRootTupleInclusionProofRequest{Height: height})
+	assert.Error(t, err)
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // TODO add unit tests for all of these requests
@@ -20,3 +22,18 @@ func (k Keeper) LatestValsetRequestBeforeNonce(
 	}
 	return &types.QueryLatestValsetRequestBeforeNonceResponse{Valset: vs}, nil
 }
+
+// ValsetsInRange queries the valsets with a nonce in the provided range
+func (k Keeper) ValsetsInRange(
+	c context.Context,
+	req *types.QueryValsetsInRangeRequest,
+) (*types.QueryValsetsInRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	valsets, err := k.GetValsetsInRange(sdk.UnwrapSDKContext(c), req.BeginNonce, req.EndNonce)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryValsetsInRangeResponse{Valsets: valsets}, nil
+}
@@ -0,0 +1,262 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// namespacedHashSize is the size of an NMT node: the minimum namespace, the
+// maximum namespace and the digest.
+const namespacedHashSize = 2*share.NamespaceSize + 32
+
+// ABINamespace mirrors the Namespace struct of the Blobstream contracts.
+type ABINamespace struct {
+	Version [1]byte  `abi:"version"`
+	ID      [28]byte `abi:"id"`
+}
+
+// ABINamespaceNode mirrors the NamespaceNode struct of the Blobstream
+// contracts.
+type ABINamespaceNode struct {
+	Min    ABINamespace `abi:"min"`
+	Max    ABINamespace `abi:"max"`
+	Digest [32]byte     `abi:"digest"`
+}
+
+// ABINamespaceMerkleMultiproof mirrors the NamespaceMerkleMultiproof struct of
+// the Blobstream contracts.
+type ABINamespaceMerkleMultiproof struct {
+	BeginKey  *big.Int           `abi:"beginKey"`
+	EndKey    *big.Int           `abi:"endKey"`
+	SideNodes []ABINamespaceNode `abi:"sideNodes"`
+}
+
+// ABIAttestationProof mirrors the AttestationProof struct of the Blobstream
+// contracts.
+type ABIAttestationProof struct {
+	TupleRootNonce *big.Int                  `abi:"tupleRootNonce"`
+	Tuple          wrapper.DataRootTuple     `abi:"tuple"`
+	Proof          wrapper.BinaryMerkleProof `abi:"proof"`
+}
+
+// ABISharesProof mirrors the SharesProof struct that the DAVerifier library of
+// the Blobstream contracts verifies.
+type ABISharesProof struct {
+	Data             [][]byte                       `abi:"data"`
+	ShareProofs      []ABINamespaceMerkleMultiproof `abi:"shareProofs"`
+	Namespace        ABINamespace                   `abi:"namespace"`
+	RowRoots         []ABINamespaceNode             `abi:"rowRoots"`
+	RowProofs        []wrapper.BinaryMerkleProof    `abi:"rowProofs"`
+	AttestationProof ABIAttestationProof            `abi:"attestationProof"`
+}
+
+var (
+	SharesProofABI       abi.Arguments
+	DataRootTupleABI     abi.Arguments
+	BinaryMerkleProofABI abi.Arguments
+)
+
+func init() {
+	namespace := []abi.ArgumentMarshaling{
+		{Name: "version", Type: "bytes1"},
+		{Name: "id", Type: "bytes28"},
+	}
+	namespaceNode := []abi.ArgumentMarshaling{
+		{Name: "min", Type: "tuple", Components: namespace},
+		{Name: "max", Type: "tuple", Components: namespace},
+		{Name: "digest", Type: "bytes32"},
+	}
+	binaryMerkleProof := []abi.ArgumentMarshaling{
+		{Name: "sideNodes", Type: "bytes32[]"},
+		{Name: "key", Type: "uint256"},
+		{Name: "numLeaves", Type: "uint256"},
+	}
+	dataRootTuple := []abi.ArgumentMarshaling{
+		{Name: "height", Type: "uint256"},
+		{Name: "dataRoot", Type: "bytes32"},
+	}
+	sharesProof := []abi.ArgumentMarshaling{
+		{Name: "data", Type: "bytes[]"},
+		{Name: "shareProofs", Type: "tuple[]", Components: []abi.ArgumentMarshaling{
+			{Name: "beginKey", Type: "uint256"},
+			{Name: "endKey", Type: "uint256"},
+			{Name: "sideNodes", Type: "tuple[]", Components: namespaceNode},
+		}},
+		{Name: "namespace", Type: "tuple", Components: namespace},
+		{Name: "rowRoots", Type: "tuple[]", Components: namespaceNode},
+		{Name: "rowProofs", Type: "tuple[]", Components: binaryMerkleProof},
+		{Name: "attestationProof", Type: "tuple", Components: []abi.ArgumentMarshaling{
+			{Name: "tupleRootNonce", Type: "uint256"},
+			{Name: "tuple", Type: "tuple", Components: dataRootTuple},
+			{Name: "proof", Type: "tuple", Components: binaryMerkleProof},
+		}},
+	}
+
+	SharesProofABI = mustNewTupleArguments("SharesProof", sharesProof)
+	DataRootTupleABI = mustNewTupleArguments("DataRootTuple", dataRootTuple)
+	BinaryMerkleProofABI = mustNewTupleArguments("BinaryMerkleProof", binaryMerkleProof)
+}
+
+func mustNewTupleArguments(name string, components []abi.ArgumentMarshaling) abi.Arguments {
+	t, err := abi.NewType("tuple", name, components)
+	if err != nil {
+		panic(err)
+	}
+	return abi.Arguments{{Type: t, Name: name}}
+}
+
+// NewABISharesProof converts a share proof, along with the proof that the
+// (height, data root) tuple was committed to by the data commitment with the
+// given nonce, into the SharesProof expected by the Blobstream contracts.
+func NewABISharesProof(
+	sp proof.ShareProof,
+	nonce uint64,
+	height uint64,
+	dataRoot []byte,
+	dataRootTupleProof *proof.Proof,
+) (ABISharesProof, error) {
+	if sp.RowProof == nil {
+		return ABISharesProof{}, fmt.Errorf("missing row proof")
+	}
+	if len(sp.NamespaceId) != share.NamespaceIDSize {
+		return ABISharesProof{}, fmt.Errorf("namespace ID must be %d bytes, got %d", share.NamespaceIDSize, len(sp.NamespaceId))
+	}
+	if sp.NamespaceVersion > math.MaxUint8 {
+		return ABISharesProof{}, fmt.Errorf("namespace version %d is too large", sp.NamespaceVersion)
+	}
+
+	shareProofs := make([]ABINamespaceMerkleMultiproof, len(sp.ShareProofs))
+	for i, p := range sp.ShareProofs {
+		if p == nil {
+			return ABISharesProof{}, fmt.Errorf("missing share proof %d", i)
+		}
+		sideNodes, err := toABINamespaceNodes(p.Nodes)
+		if err != nil {
+			return ABISharesProof{}, err
+		}
+		shareProofs[i] = ABINamespaceMerkleMultiproof{
+			BeginKey:  big.NewInt(int64(p.Start)),
+			EndKey:    big.NewInt(int64(p.End)),
+			SideNodes: sideNodes,
+		}
+	}
+
+	rowRoots, err := toABINamespaceNodes(sp.RowProof.RowRoots)
+	if err != nil {
+		return ABISharesProof{}, err
+	}
+
+	rowProofs := make([]wrapper.BinaryMerkleProof, len(sp.RowProof.Proofs))
+	for i, p := range sp.RowProof.Proofs {
+		rowProofs[i], err = NewABIBinaryMerkleProof(p)
+		if err != nil {
+			return ABISharesProof{}, err
+		}
+	}
+
+	tuple, err := NewABIDataRootTuple(height, dataRoot)
+	if err != nil {
+		return ABISharesProof{}, err
+	}
+	tupleProof, err := NewABIBinaryMerkleProof(dataRootTupleProof)
+	if err != nil {
+		return ABISharesProof{}, err
+	}
+
+	namespace := ABINamespace{Version: [1]byte{byte(sp.NamespaceVersion)}}
+	copy(namespace.ID[:], sp.NamespaceId)
+
+	return ABISharesProof{
+		Data:        sp.Data,
+		ShareProofs: shareProofs,
+		Namespace:   namespace,
+		RowRoots:    rowRoots,
+		RowProofs:   rowProofs,
+		AttestationProof: ABIAttestationProof{
+			TupleRootNonce: new(big.Int).SetUint64(nonce),
+			Tuple:          tuple,
+			Proof:          tupleProof,
+		},
+	}, nil
+}
+
+// NewABIDataRootTuple returns the DataRootTuple expected by the Blobstream
+// contracts.
+func NewABIDataRootTuple(height uint64, dataRoot []byte) (wrapper.DataRootTuple, error) {
+	if len(dataRoot) != 32 {
+		return wrapper.DataRootTuple{}, fmt.Errorf("data root must be 32 bytes, got %d", len(dataRoot))
+	}
+	return wrapper.DataRootTuple{
+		Height:   new(big.Int).SetUint64(height),
+		DataRoot: *(*[32]byte)(dataRoot),
+	}, nil
+}
+
+// NewABIBinaryMerkleProof returns the BinaryMerkleProof expected by the
+// Blobstream contracts.
+func NewABIBinaryMerkleProof(p *proof.Proof) (wrapper.BinaryMerkleProof, error) {
+	if p == nil {
+		return wrapper.BinaryMerkleProof{}, fmt.Errorf("missing binary merkle proof")
+	}
+	sideNodes := make([][32]byte, len(p.Aunts))
+	for i, aunt := range p.Aunts {
+		if len(aunt) != 32 {
+			return wrapper.BinaryMerkleProof{}, fmt.Errorf("side node must be 32 bytes, got %d", len(aunt))
+		}
+		sideNodes[i] = *(*[32]byte)(aunt)
+	}
+	return wrapper.BinaryMerkleProof{
+		SideNodes: sideNodes,
+		Key:       big.NewInt(p.Index),
+		NumLeaves: big.NewInt(p.Total),
+	}, nil
+}
+
+// EncodeSharesProof returns the ABI encoding of the SharesProof, i.e.
+// abi.encode(proof), as expected by the DAVerifier library.
+func EncodeSharesProof(p ABISharesProof) ([]byte, error) {
+	return SharesProofABI.Pack(p)
+}
+
+// EncodeDataRootTuple returns the ABI encoding of the DataRootTuple.
+func EncodeDataRootTuple(tuple wrapper.DataRootTuple) ([]byte, error) {
+	return DataRootTupleABI.Pack(tuple)
+}
+
+// EncodeBinaryMerkleProof returns the ABI encoding of the BinaryMerkleProof.
+func EncodeBinaryMerkleProof(p wrapper.BinaryMerkleProof) ([]byte, error) {
+	return BinaryMerkleProofABI.Pack(p)
+}
+
+// EncodeVerifyAttestationCalldata returns the calldata, including the function
+// selector, of a call to verifyAttestation on the Blobstream contract.
+func EncodeVerifyAttestationCalldata(nonce uint64, tuple wrapper.DataRootTuple, p wrapper.BinaryMerkleProof) ([]byte, error) {
+	return ExternalBlobstreamABI.Pack("verifyAttestation", new(big.Int).SetUint64(nonce), tuple, p)
+}
+
+func toABINamespaceNodes(nodes [][]byte) ([]ABINamespaceNode, error) {
+	abiNodes := make([]ABINamespaceNode, len(nodes))
+	for i, node := range nodes {
+		if len(node) != namespacedHashSize {
+			return nil, fmt.Errorf("namespaced hash must be %d bytes, got %d", namespacedHashSize, len(node))
+		}
+		abiNodes[i] = ABINamespaceNode{
+			Min:    toABINamespace(node[:share.NamespaceSize]),
+			Max:    toABINamespace(node[share.NamespaceSize : 2*share.NamespaceSize]),
+			Digest: *(*[32]byte)(node[2*share.NamespaceSize:]),
+		}
+	}
+	return abiNodes, nil
+}
+
+func toABINamespace(ns []byte) ABINamespace {
+	namespace := ABINamespace{Version: [1]byte{ns[0]}}
+	copy(namespace.ID[:], ns[1:])
+	return namespace
+}
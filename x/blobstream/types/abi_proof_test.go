@@ -0,0 +1,123 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestEncodeSharesProof(t *testing.T) {
+	sharesProof, dataRoot, tupleProof := newABITestProofs(t)
+
+	p, err := types.NewABISharesProof(sharesProof, 10, 3, dataRoot, tupleProof)
+	require.NoError(t, err)
+	require.Equal(t, sharesProof.Data, p.Data)
+	require.Len(t, p.ShareProofs, len(sharesProof.ShareProofs))
+	require.Len(t, p.RowRoots, len(sharesProof.RowProof.RowRoots))
+	require.Len(t, p.RowProofs, len(sharesProof.RowProof.Proofs))
+	require.Equal(t, share.TxNamespace.ID(), p.Namespace.ID[:])
+	require.Equal(t, share.TxNamespace.Version(), p.Namespace.Version[0])
+	for i, root := range sharesProof.RowProof.RowRoots {
+		require.Equal(t, root[:share.NamespaceSize], append(p.RowRoots[i].Min.Version[:], p.RowRoots[i].Min.ID[:]...))
+		require.Equal(t, root[2*share.NamespaceSize:], p.RowRoots[i].Digest[:])
+	}
+
+	bz, err := types.EncodeSharesProof(p)
+	require.NoError(t, err)
+
+	unpacked, err := types.SharesProofABI.Unpack(bz)
+	require.NoError(t, err)
+	require.Len(t, unpacked, 1)
+	decoded := *abi.ConvertType(unpacked[0], new(types.ABISharesProof)).(*types.ABISharesProof)
+	require.Equal(t, p.Data, decoded.Data)
+	require.Equal(t, p.RowRoots, decoded.RowRoots)
+	require.Equal(t, p.AttestationProof.Tuple.DataRoot, decoded.AttestationProof.Tuple.DataRoot)
+	reencoded, err := types.EncodeSharesProof(decoded)
+	require.NoError(t, err)
+	require.Equal(t, bz, reencoded)
+}
+
+func TestNewABISharesProofInvalid(t *testing.T) {
+	sharesProof, dataRoot, tupleProof := newABITestProofs(t)
+
+	invalidNamespace := sharesProof
+	invalidNamespace.NamespaceId = []byte{1}
+	_, err := types.NewABISharesProof(invalidNamespace, 10, 3, dataRoot, tupleProof)
+	require.Error(t, err)
+
+	_, err = types.NewABISharesProof(sharesProof, 10, 3, dataRoot[:31], tupleProof)
+	require.Error(t, err)
+
+	_, err = types.NewABISharesProof(sharesProof, 10, 3, dataRoot, nil)
+	require.Error(t, err)
+
+	missingRowProof := sharesProof
+	missingRowProof.RowProof = nil
+	_, err = types.NewABISharesProof(missingRowProof, 10, 3, dataRoot, tupleProof)
+	require.Error(t, err)
+}
+
+func TestEncodeDataRootTuple(t *testing.T) {
+	dataRoot := tmrand.Bytes(32)
+	tuple, err := types.NewABIDataRootTuple(256, dataRoot)
+	require.NoError(t, err)
+
+	bz, err := types.EncodeDataRootTuple(tuple)
+	require.NoError(t, err)
+	// the data root tuple is a static type so its ABI encoding is the same as
+	// the one of the data root tuple leaves.
+	require.Equal(t, proof.EncodeDataRootTuple(256, *(*[32]byte)(dataRoot)), bz)
+}
+
+func TestEncodeVerifyAttestationCalldata(t *testing.T) {
+	_, dataRoot, tupleProof := newABITestProofs(t)
+	tuple, err := types.NewABIDataRootTuple(3, dataRoot)
+	require.NoError(t, err)
+	p, err := types.NewABIBinaryMerkleProof(tupleProof)
+	require.NoError(t, err)
+
+	calldata, err := types.EncodeVerifyAttestationCalldata(10, tuple, p)
+	require.NoError(t, err)
+
+	method := types.ExternalBlobstreamABI.Methods["verifyAttestation"]
+	require.True(t, bytes.HasPrefix(calldata, method.ID))
+
+	args, err := method.Inputs.Unpack(calldata[len(method.ID):])
+	require.NoError(t, err)
+	require.Len(t, args, 3)
+
+	encodedProof, err := types.EncodeBinaryMerkleProof(p)
+	require.NoError(t, err)
+	packedProof, err := types.BinaryMerkleProofABI.Pack(args[2])
+	require.NoError(t, err)
+	require.Equal(t, encodedProof, packedProof)
+}
+
+// newABITestProofs returns a share proof for the first transaction shares of
+// a random square, its data root and a data root tuple proof.
+func newABITestProofs(t *testing.T) (proof.ShareProof, []byte, *proof.Proof) {
+	txs := testfactory.GenerateRandomTxs(10, 500)
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	sharesProof, err := proof.NewShareInclusionProof(dataSquare, share.TxNamespace, share.NewRange(0, 2))
+	require.NoError(t, err)
+
+	_, tupleProofs := merkle.ProofsFromByteSlices([][]byte{tmrand.Bytes(64), tmrand.Bytes(64), tmrand.Bytes(64)})
+	return sharesProof, dah.Hash(), proof.ProofFromMerkle(*tupleProofs[1])
+}
@@ -16,3 +16,27 @@ type AttestationRequestI interface {
 	GetNonce() uint64
 	BlockTime() time.Time
 }
+
+const (
+	// DefaultAttestationsQueryLimit is the number of attestations returned by
+	// the Attestations query when no limit is provided.
+	DefaultAttestationsQueryLimit = 100
+	// MaxAttestationsQueryLimit is the maximum number of attestation nonces
+	// read by a single Attestations or ValsetsInRange query.
+	MaxAttestationsQueryLimit = 1000
+)
+
+// Matches returns true if the attestation is of type t. All attestations match
+// AttestationTypeUnspecified.
+func (t AttestationType) Matches(at AttestationRequestI) bool {
+	switch t {
+	case AttestationTypeValset:
+		_, ok := at.(*Valset)
+		return ok
+	case AttestationTypeDataCommitment:
+		_, ok := at.(*DataCommitment)
+		return ok
+	default:
+		return true
+	}
+}
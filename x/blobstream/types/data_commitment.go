@@ -1,6 +1,13 @@
 package types
 
-import "time"
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
 
 var _ AttestationRequestI = &DataCommitment{}
 
@@ -22,3 +29,21 @@ func NewDataCommitment(
 func (m *DataCommitment) BlockTime() time.Time {
 	return m.Time
 }
+
+// DataRootTupleRootSignBytes produces the bytes that celestia validators are
+// required to sign over when a data root tuple root is relayed to the
+// Blobstream contract with the given nonce.
+func DataRootTupleRootSignBytes(nonce uint64, dataRootTupleRoot [32]byte) ethcmn.Hash {
+	bytes, err := InternalBlobstreamABI.Pack(
+		"domainSeparateDataRootTupleRoot",
+		DcDomainSeparator,
+		new(big.Int).SetUint64(nonce),
+		dataRootTupleRoot,
+	)
+	// this should never happen as all the arguments have a fixed size.
+	if err != nil {
+		panic(fmt.Sprintf("Error packing data root tuple root! %s", err))
+	}
+
+	return crypto.Keccak256Hash(bytes[4:])
+}
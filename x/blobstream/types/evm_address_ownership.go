@@ -0,0 +1,62 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// EVMSignatureLength is the length of an EVM signature in the [R || S || V]
+// format.
+const EVMSignatureLength = crypto.SignatureLength
+
+// EVMAddressOwnershipMessage returns the message that is signed by the EVM key
+// to prove that the validator controls the EVM address it registers. The
+// message commits to the validator address and the chain ID so that it can't
+// be replayed for another validator or on another chain.
+func EVMAddressOwnershipMessage(validatorAddress string, chainID string) []byte {
+	return []byte(fmt.Sprintf(
+		"Register EVM address for Blobstream validator %s on chain %s",
+		validatorAddress,
+		chainID,
+	))
+}
+
+// SignEVMAddressOwnership returns the EIP-191 personal_sign signature of the
+// ownership message by the EVM key.
+func SignEVMAddressOwnership(key *ecdsa.PrivateKey, validatorAddress string, chainID string) ([]byte, error) {
+	signature, err := crypto.Sign(accounts.TextHash(EVMAddressOwnershipMessage(validatorAddress, chainID)), key)
+	if err != nil {
+		return nil, err
+	}
+	// personal_sign signatures use 27 or 28 as recovery ID
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+// VerifyEVMAddressOwnership checks that the signature is a valid EIP-191
+// personal_sign signature of the ownership message by the EVM address.
+// Recovery IDs of both 0/1 and 27/28 are accepted.
+func VerifyEVMAddressOwnership(evmAddress common.Address, validatorAddress string, chainID string, signature []byte) error {
+	if len(signature) != EVMSignatureLength {
+		return errors.Wrapf(ErrInvalidEVMAddressSignature, "signature must be %d bytes, got %d", EVMSignatureLength, len(signature))
+	}
+	sig := make([]byte, EVMSignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(accounts.TextHash(EVMAddressOwnershipMessage(validatorAddress, chainID)), sig)
+	if err != nil {
+		return errors.Wrap(ErrInvalidEVMAddressSignature, err.Error())
+	}
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != evmAddress {
+		return errors.Wrapf(ErrInvalidEVMAddressSignature, "signed by %s instead of %s", signer.Hex(), evmAddress.Hex())
+	}
+	return nil
+}
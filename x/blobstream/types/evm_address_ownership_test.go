@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyEVMAddressOwnership(t *testing.T) {
+	const (
+		valAddr = "celestiavaloper1xcy3els9ua75kdm783c3qu0rfa2eplestc6sqc"
+		chainID = "test-chain"
+	)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	evmAddr := crypto.PubkeyToAddress(key.PublicKey)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	signature, err := types.SignEVMAddressOwnership(key, valAddr, chainID)
+	require.NoError(t, err)
+	require.Len(t, signature, types.EVMSignatureLength)
+
+	// signatures with a 0/1 recovery ID are also accepted
+	rawSignature := append([]byte{}, signature...)
+	rawSignature[crypto.RecoveryIDOffset] -= 27
+
+	otherSignature, err := types.SignEVMAddressOwnership(otherKey, valAddr, chainID)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		valAddr   string
+		chainID   string
+		signature []byte
+		expectErr bool
+	}{
+		{"valid signature", valAddr, chainID, signature, false},
+		{"valid signature with raw recovery ID", valAddr, chainID, rawSignature, false},
+		{"other validator", "celestiavaloper1ktv4sqrzkpmmgk5wx4hhm4kthr4yxdn5lqsyv4", chainID, signature, true},
+		{"other chain", valAddr, "other-chain", signature, true},
+		{"signed by another key", valAddr, chainID, otherSignature, true},
+		{"short signature", valAddr, chainID, signature[:64], true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.VerifyEVMAddressOwnership(evmAddr, tt.valAddr, tt.chainID, tt.signature)
+			if tt.expectErr {
+				require.ErrorIs(t, err, types.ErrInvalidEVMAddressSignature)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -2,10 +2,12 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 
 	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -16,13 +18,32 @@ const (
 	// MinimumDataCommitmentWindow is a constant that defines the minimum
 	// allowable window for the Blobstream data commitments.
 	MinimumDataCommitmentWindow = 100
+
+	// DefaultAttestationExpiryTime is the default expiration time of an
+	// attestation.
+	DefaultAttestationExpiryTime = 3 * 7 * 24 * time.Hour // 3 weeks
 )
 
-// ParamsStoreKeyDataCommitmentWindow is the key used for the
-// DataCommitmentWindow param.
-var ParamsStoreKeyDataCommitmentWindow = []byte("DataCommitmentWindow")
+// DefaultSignificantPowerDifferenceThreshold is the default threshold of
+// change in the validator set power that triggers the creation of a new
+// valset.
+var DefaultSignificantPowerDifferenceThreshold = sdk.NewDecWithPrec(5, 2) // 0.05
+
+var (
+	// ParamsStoreKeyDataCommitmentWindow is the key used for the
+	// DataCommitmentWindow param.
+	ParamsStoreKeyDataCommitmentWindow = []byte("DataCommitmentWindow")
+	// ParamsStoreKeyAttestationExpiryTime is the key used for the
+	// AttestationExpiryTime param.
+	ParamsStoreKeyAttestationExpiryTime = []byte("AttestationExpiryTime")
+	// ParamsStoreKeySignificantPowerDifferenceThreshold is the key used for the
+	// SignificantPowerDifferenceThreshold param.
+	ParamsStoreKeySignificantPowerDifferenceThreshold = []byte("SignificantPowerDifferenceThreshold")
+)
 
-// DefaultGenesis returns the default Capability genesis state
+// DefaultGenesis returns the default Capability genesis state. The attestation
+// expiry time and significant power difference threshold are left unset so
+// that the defaults are used without being written to state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Params: &Params{
@@ -31,6 +52,19 @@ func DefaultGenesis() *GenesisState {
 	}
 }
 
+// WithDefaults returns the params where the unset, i.e. zero, attestation
+// expiry time and significant power difference threshold are replaced by
+// their default values.
+func (p Params) WithDefaults() Params {
+	if p.AttestationExpiryTime == 0 {
+		p.AttestationExpiryTime = DefaultAttestationExpiryTime
+	}
+	if p.SignificantPowerDifferenceThreshold.IsNil() || p.SignificantPowerDifferenceThreshold.IsZero() {
+		p.SignificantPowerDifferenceThreshold = DefaultSignificantPowerDifferenceThreshold
+	}
+	return p
+}
+
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
@@ -51,6 +85,8 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamsStoreKeyDataCommitmentWindow, &p.DataCommitmentWindow, validateDataCommitmentWindow),
+		paramtypes.NewParamSetPair(ParamsStoreKeyAttestationExpiryTime, &p.AttestationExpiryTime, validateAttestationExpiryTime),
+		paramtypes.NewParamSetPair(ParamsStoreKeySignificantPowerDifferenceThreshold, &p.SignificantPowerDifferenceThreshold, validateSignificantPowerDifferenceThreshold),
 	}
 }
 
@@ -75,10 +111,46 @@ func validateDataCommitmentWindow(i interface{}) error {
 	return nil
 }
 
-// ValidateBasic checks that the parameters have valid values.
+func validateAttestationExpiryTime(i interface{}) error {
+	val, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val <= 0 {
+		return errors.Wrap(ErrInvalidAttestationExpiryTime, fmt.Sprintf(
+			"attestation expiry time %v must be positive",
+			val,
+		))
+	}
+	return nil
+}
+
+func validateSignificantPowerDifferenceThreshold(i interface{}) error {
+	val, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val.IsNil() || !val.IsPositive() || val.GTE(sdk.OneDec()) {
+		return errors.Wrap(ErrInvalidSignificantPowerDifferenceThreshold, fmt.Sprintf(
+			"significant power difference threshold %v must be > 0 and < 1",
+			val,
+		))
+	}
+	return nil
+}
+
+// ValidateBasic checks that the parameters have valid values. The attestation
+// expiry time and significant power difference threshold can be unset.
 func (p Params) ValidateBasic() error {
 	if err := validateDataCommitmentWindow(p.DataCommitmentWindow); err != nil {
 		return errors.Wrap(err, "data commitment window")
 	}
+	p = p.WithDefaults()
+	if err := validateAttestationExpiryTime(p.AttestationExpiryTime); err != nil {
+		return errors.Wrap(err, "attestation expiry time")
+	}
+	if err := validateSignificantPowerDifferenceThreshold(p.SignificantPowerDifferenceThreshold); err != nil {
+		return errors.Wrap(err, "significant power difference threshold")
+	}
 	return nil
 }
@@ -2,11 +2,13 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -57,6 +59,43 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			expErr: false,
 		},
+		"valid params: custom attestation expiry time and power difference threshold": {
+			src: &types.GenesisState{
+				Params: &types.Params{
+					DataCommitmentWindow:                types.MinimumDataCommitmentWindow,
+					AttestationExpiryTime:               time.Hour,
+					SignificantPowerDifferenceThreshold: sdk.NewDecWithPrec(1, 1),
+				},
+			},
+			expErr: false,
+		},
+		"invalid params: negative attestation expiry time": {
+			src: &types.GenesisState{
+				Params: &types.Params{
+					DataCommitmentWindow:  types.MinimumDataCommitmentWindow,
+					AttestationExpiryTime: -time.Hour,
+				},
+			},
+			expErr: true,
+		},
+		"invalid params: negative power difference threshold": {
+			src: &types.GenesisState{
+				Params: &types.Params{
+					DataCommitmentWindow:                types.MinimumDataCommitmentWindow,
+					SignificantPowerDifferenceThreshold: sdk.NewDecWithPrec(-1, 1),
+				},
+			},
+			expErr: true,
+		},
+		"invalid params: power difference threshold of one": {
+			src: &types.GenesisState{
+				Params: &types.Params{
+					DataCommitmentWindow:                types.MinimumDataCommitmentWindow,
+					SignificantPowerDifferenceThreshold: sdk.OneDec(),
+				},
+			},
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -69,3 +108,16 @@ func TestGenesisStateValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestParamsWithDefaults(t *testing.T) {
+	params := types.DefaultGenesis().Params.WithDefaults()
+	require.Equal(t, types.DefaultAttestationExpiryTime, params.AttestationExpiryTime)
+	require.Equal(t, types.DefaultSignificantPowerDifferenceThreshold, params.SignificantPowerDifferenceThreshold)
+
+	custom := types.Params{
+		DataCommitmentWindow:                types.MinimumDataCommitmentWindow,
+		AttestationExpiryTime:               time.Hour,
+		SignificantPowerDifferenceThreshold: sdk.NewDecWithPrec(1, 1),
+	}
+	require.Equal(t, custom, custom.WithDefaults())
+}
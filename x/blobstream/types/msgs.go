@@ -1,6 +1,7 @@
 package types
 
 import (
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -26,6 +27,10 @@ func (msg MsgRegisterEVMAddress) ValidateBasic() error {
 		return ErrEVMAddressNotHex
 	}
 
+	if len(msg.EvmSignature) != 0 && len(msg.EvmSignature) != EVMSignatureLength {
+		return errors.Wrapf(ErrInvalidEVMAddressSignature, "signature must be %d bytes, got %d", EVMSignatureLength, len(msg.EvmSignature))
+	}
+
 	return nil
 }
 
@@ -15,8 +15,12 @@ func TestValidateBasic(t *testing.T) {
 
 	msg := NewMsgRegisterEVMAddress(valAddr, evmAddr)
 	require.NoError(t, msg.ValidateBasic())
-	msg = &MsgRegisterEVMAddress{valAddr.String(), "invalid evm address"}
+	msg = &MsgRegisterEVMAddress{ValidatorAddress: valAddr.String(), EvmAddress: "invalid evm address"}
 	require.Error(t, msg.ValidateBasic())
-	msg = &MsgRegisterEVMAddress{"invalid validator address", evmAddr.Hex()}
+	msg = &MsgRegisterEVMAddress{ValidatorAddress: "invalid validator address", EvmAddress: evmAddr.Hex()}
+	require.Error(t, msg.ValidateBasic())
+	msg = &MsgRegisterEVMAddress{ValidatorAddress: valAddr.String(), EvmAddress: evmAddr.Hex(), EvmSignature: make([]byte, EVMSignatureLength)}
+	require.NoError(t, msg.ValidateBasic())
+	msg = &MsgRegisterEVMAddress{ValidatorAddress: valAddr.String(), EvmAddress: evmAddr.Hex(), EvmSignature: []byte{1}}
 	require.Error(t, msg.ValidateBasic())
 }
@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	crypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -31,6 +32,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AttestationType filters the attestations returned by the Attestations query.
+type AttestationType int32
+
+const (
+	// ATTESTATION_TYPE_UNSPECIFIED matches all attestations.
+	AttestationTypeUnspecified AttestationType = 0
+	// ATTESTATION_TYPE_VALSET matches valsets.
+	AttestationTypeValset AttestationType = 1
+	// ATTESTATION_TYPE_DATA_COMMITMENT matches data commitments.
+	AttestationTypeDataCommitment AttestationType = 2
+)
+
+var AttestationType_name = map[int32]string{
+	0: "ATTESTATION_TYPE_UNSPECIFIED",
+	1: "ATTESTATION_TYPE_VALSET",
+	2: "ATTESTATION_TYPE_DATA_COMMITMENT",
+}
+
+var AttestationType_value = map[string]int32{
+	"ATTESTATION_TYPE_UNSPECIFIED":     0,
+	"ATTESTATION_TYPE_VALSET":          1,
+	"ATTESTATION_TYPE_DATA_COMMITMENT": 2,
+}
+
+func (x AttestationType) String() string {
+	return proto.EnumName(AttestationType_name, int32(x))
+}
+
+func (AttestationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{0}
+}
+
 // QueryParamsRequest
 type QueryParamsRequest struct {
 }
@@ -372,6 +405,226 @@ func (m *QueryEarliestAttestationNonceResponse) GetNonce() uint64 {
 	return 0
 }
 
+// QueryAttestationsRequest
+type QueryAttestationsRequest struct {
+	// from_nonce is the nonce of the first attestation to return. If zero, the
+	// attestations start at the earliest available nonce.
+	FromNonce uint64 `protobuf:"varint,1,opt,name=from_nonce,json=fromNonce,proto3" json:"from_nonce,omitempty"`
+	// limit is the maximum number of attestations to return. If zero, a default
+	// limit is used.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// type_filter restricts the returned attestations to a single type.
+	TypeFilter AttestationType `protobuf:"varint,3,opt,name=type_filter,json=typeFilter,proto3,enum=celestia.qgb.v1.AttestationType" json:"type_filter,omitempty"`
+}
+
+func (m *QueryAttestationsRequest) Reset()         { *m = QueryAttestationsRequest{} }
+func (m *QueryAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsRequest) ProtoMessage()    {}
+func (*QueryAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{8}
+}
+func (m *QueryAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsRequest.Merge(m, src)
+}
+func (m *QueryAttestationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationsRequest proto.InternalMessageInfo
+
+func (m *QueryAttestationsRequest) GetFromNonce() uint64 {
+	if m != nil {
+		return m.FromNonce
+	}
+	return 0
+}
+
+func (m *QueryAttestationsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *QueryAttestationsRequest) GetTypeFilter() AttestationType {
+	if m != nil {
+		return m.TypeFilter
+	}
+	return AttestationTypeUnspecified
+}
+
+// QueryAttestationsResponse
+type QueryAttestationsResponse struct {
+	// attestations are either Data Commitments or Valsets.
+	Attestations []*types.Any `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	// next_nonce is the nonce to query the next page from. It is zero if there
+	// are no more attestations.
+	NextNonce uint64 `protobuf:"varint,2,opt,name=next_nonce,json=nextNonce,proto3" json:"next_nonce,omitempty"`
+}
+
+func (m *QueryAttestationsResponse) Reset()         { *m = QueryAttestationsResponse{} }
+func (m *QueryAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsResponse) ProtoMessage()    {}
+func (*QueryAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{9}
+}
+func (m *QueryAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsResponse.Merge(m, src)
+}
+func (m *QueryAttestationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationsResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationsResponse) GetAttestations() []*types.Any {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *QueryAttestationsResponse) GetNextNonce() uint64 {
+	if m != nil {
+		return m.NextNonce
+	}
+	return 0
+}
+
+// QueryValsetsInRangeRequest
+type QueryValsetsInRangeRequest struct {
+	BeginNonce uint64 `protobuf:"varint,1,opt,name=begin_nonce,json=beginNonce,proto3" json:"begin_nonce,omitempty"`
+	EndNonce   uint64 `protobuf:"varint,2,opt,name=end_nonce,json=endNonce,proto3" json:"end_nonce,omitempty"`
+}
+
+func (m *QueryValsetsInRangeRequest) Reset()         { *m = QueryValsetsInRangeRequest{} }
+func (m *QueryValsetsInRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetsInRangeRequest) ProtoMessage()    {}
+func (*QueryValsetsInRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{10}
+}
+func (m *QueryValsetsInRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValsetsInRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValsetsInRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValsetsInRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetsInRangeRequest.Merge(m, src)
+}
+func (m *QueryValsetsInRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValsetsInRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetsInRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValsetsInRangeRequest proto.InternalMessageInfo
+
+func (m *QueryValsetsInRangeRequest) GetBeginNonce() uint64 {
+	if m != nil {
+		return m.BeginNonce
+	}
+	return 0
+}
+
+func (m *QueryValsetsInRangeRequest) GetEndNonce() uint64 {
+	if m != nil {
+		return m.EndNonce
+	}
+	return 0
+}
+
+// QueryValsetsInRangeResponse
+type QueryValsetsInRangeResponse struct {
+	Valsets []Valset `protobuf:"bytes,1,rep,name=valsets,proto3" json:"valsets"`
+}
+
+func (m *QueryValsetsInRangeResponse) Reset()         { *m = QueryValsetsInRangeResponse{} }
+func (m *QueryValsetsInRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetsInRangeResponse) ProtoMessage()    {}
+func (*QueryValsetsInRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{11}
+}
+func (m *QueryValsetsInRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValsetsInRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValsetsInRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValsetsInRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetsInRangeResponse.Merge(m, src)
+}
+func (m *QueryValsetsInRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValsetsInRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetsInRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValsetsInRangeResponse proto.InternalMessageInfo
+
+func (m *QueryValsetsInRangeResponse) GetValsets() []Valset {
+	if m != nil {
+		return m.Valsets
+	}
+	return nil
+}
+
 // QueryLatestValsetRequestBeforeNonceRequest latest Valset request before
 // universal nonce request
 type QueryLatestValsetRequestBeforeNonceRequest struct {
@@ -386,7 +639,7 @@ func (m *QueryLatestValsetRequestBeforeNonceRequest) String() string {
 }
 func (*QueryLatestValsetRequestBeforeNonceRequest) ProtoMessage() {}
 func (*QueryLatestValsetRequestBeforeNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{8}
+	return fileDescriptor_c8535c57355a2b91, []int{12}
 }
 func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,7 +689,7 @@ func (m *QueryLatestValsetRequestBeforeNonceResponse) String() string {
 }
 func (*QueryLatestValsetRequestBeforeNonceResponse) ProtoMessage() {}
 func (*QueryLatestValsetRequestBeforeNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{9}
+	return fileDescriptor_c8535c57355a2b91, []int{13}
 }
 func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,7 +733,7 @@ func (m *QueryLatestUnbondingHeightRequest) Reset()         { *m = QueryLatestUn
 func (m *QueryLatestUnbondingHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestUnbondingHeightRequest) ProtoMessage()    {}
 func (*QueryLatestUnbondingHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{10}
+	return fileDescriptor_c8535c57355a2b91, []int{14}
 }
 func (m *QueryLatestUnbondingHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +771,7 @@ func (m *QueryLatestUnbondingHeightResponse) Reset()         { *m = QueryLatestU
 func (m *QueryLatestUnbondingHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestUnbondingHeightResponse) ProtoMessage()    {}
 func (*QueryLatestUnbondingHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{11}
+	return fileDescriptor_c8535c57355a2b91, []int{15}
 }
 func (m *QueryLatestUnbondingHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,7 +815,7 @@ func (m *QueryLatestDataCommitmentRequest) Reset()         { *m = QueryLatestDat
 func (m *QueryLatestDataCommitmentRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestDataCommitmentRequest) ProtoMessage()    {}
 func (*QueryLatestDataCommitmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{12}
+	return fileDescriptor_c8535c57355a2b91, []int{16}
 }
 func (m *QueryLatestDataCommitmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,7 +853,7 @@ func (m *QueryLatestDataCommitmentResponse) Reset()         { *m = QueryLatestDa
 func (m *QueryLatestDataCommitmentResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestDataCommitmentResponse) ProtoMessage()    {}
 func (*QueryLatestDataCommitmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{13}
+	return fileDescriptor_c8535c57355a2b91, []int{17}
 }
 func (m *QueryLatestDataCommitmentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -647,7 +900,7 @@ func (m *QueryDataCommitmentRangeForHeightRequest) Reset() {
 func (m *QueryDataCommitmentRangeForHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDataCommitmentRangeForHeightRequest) ProtoMessage()    {}
 func (*QueryDataCommitmentRangeForHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{14}
+	return fileDescriptor_c8535c57355a2b91, []int{18}
 }
 func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -696,7 +949,7 @@ func (m *QueryDataCommitmentRangeForHeightResponse) String() string {
 }
 func (*QueryDataCommitmentRangeForHeightResponse) ProtoMessage() {}
 func (*QueryDataCommitmentRangeForHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{15}
+	return fileDescriptor_c8535c57355a2b91, []int{19}
 }
 func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -741,7 +994,7 @@ func (m *QueryEVMAddressRequest) Reset()         { *m = QueryEVMAddressRequest{}
 func (m *QueryEVMAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEVMAddressRequest) ProtoMessage()    {}
 func (*QueryEVMAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{16}
+	return fileDescriptor_c8535c57355a2b91, []int{20}
 }
 func (m *QueryEVMAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -786,7 +1039,7 @@ func (m *QueryEVMAddressResponse) Reset()         { *m = QueryEVMAddressResponse
 func (m *QueryEVMAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEVMAddressResponse) ProtoMessage()    {}
 func (*QueryEVMAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{17}
+	return fileDescriptor_c8535c57355a2b91, []int{21}
 }
 func (m *QueryEVMAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -822,158 +1075,431 @@ func (m *QueryEVMAddressResponse) GetEvmAddress() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.qgb.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.qgb.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryAttestationRequestByNonceRequest)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceRequest")
-	proto.RegisterType((*QueryAttestationRequestByNonceResponse)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceResponse")
-	proto.RegisterType((*QueryLatestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceRequest")
-	proto.RegisterType((*QueryLatestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceResponse")
-	proto.RegisterType((*QueryEarliestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceRequest")
-	proto.RegisterType((*QueryEarliestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceResponse")
-	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceRequest)(nil), "celestia.qgb.v1.QueryLatestValsetRequestBeforeNonceRequest")
-	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceResponse)(nil), "celestia.qgb.v1.QueryLatestValsetRequestBeforeNonceResponse")
-	proto.RegisterType((*QueryLatestUnbondingHeightRequest)(nil), "celestia.qgb.v1.QueryLatestUnbondingHeightRequest")
-	proto.RegisterType((*QueryLatestUnbondingHeightResponse)(nil), "celestia.qgb.v1.QueryLatestUnbondingHeightResponse")
-	proto.RegisterType((*QueryLatestDataCommitmentRequest)(nil), "celestia.qgb.v1.QueryLatestDataCommitmentRequest")
-	proto.RegisterType((*QueryLatestDataCommitmentResponse)(nil), "celestia.qgb.v1.QueryLatestDataCommitmentResponse")
-	proto.RegisterType((*QueryDataCommitmentRangeForHeightRequest)(nil), "celestia.qgb.v1.QueryDataCommitmentRangeForHeightRequest")
-	proto.RegisterType((*QueryDataCommitmentRangeForHeightResponse)(nil), "celestia.qgb.v1.QueryDataCommitmentRangeForHeightResponse")
-	proto.RegisterType((*QueryEVMAddressRequest)(nil), "celestia.qgb.v1.QueryEVMAddressRequest")
-	proto.RegisterType((*QueryEVMAddressResponse)(nil), "celestia.qgb.v1.QueryEVMAddressResponse")
+// QueryDataCommitmentRootRequest
+type QueryDataCommitmentRootRequest struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func init() { proto.RegisterFile("celestia/qgb/v1/query.proto", fileDescriptor_c8535c57355a2b91) }
-
-var fileDescriptor_c8535c57355a2b91 = []byte{
-	// 910 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0x63, 0xd4, 0x46, 0xe2, 0x45, 0xea, 0x8f, 0x49, 0x9a, 0xed, 0xba, 0xc5, 0xbb, 0x75,
-	0xb2, 0xe9, 0x96, 0x65, 0x3d, 0xda, 0x2c, 0x5d, 0x44, 0x5b, 0x0e, 0x1b, 0x28, 0x2a, 0x52, 0x81,
-	0x12, 0x89, 0x1e, 0x38, 0xb0, 0x1a, 0x27, 0x53, 0xaf, 0x45, 0xec, 0x49, 0x6c, 0x27, 0x22, 0x02,
-	0x2e, 0xfc, 0x05, 0x48, 0x1c, 0x39, 0x73, 0xe5, 0x84, 0xb8, 0x70, 0xe4, 0x52, 0xf5, 0x54, 0x89,
-	0x0b, 0x27, 0x84, 0x76, 0xf9, 0x0f, 0xf8, 0x07, 0x50, 0xe6, 0x47, 0xd6, 0x71, 0x6c, 0x27, 0x59,
-	0xf5, 0xe6, 0x99, 0xf7, 0xde, 0xf7, 0x7d, 0x9e, 0x33, 0xfe, 0x4e, 0xe0, 0x46, 0x87, 0xf6, 0x68,
-	0x18, 0xb9, 0x04, 0x0f, 0x1c, 0x1b, 0x8f, 0xf6, 0xf0, 0x60, 0x48, 0x83, 0xb1, 0xd5, 0x0f, 0x58,
-	0xc4, 0xd0, 0x65, 0x15, 0xb4, 0x06, 0x8e, 0x6d, 0x8d, 0xf6, 0xf4, 0x37, 0x92, 0xd9, 0x0e, 0xf5,
-	0x69, 0xe8, 0x86, 0x22, 0x5f, 0x9f, 0x13, 0x8b, 0xc6, 0x7d, 0xaa, 0x82, 0x37, 0x1d, 0xc6, 0x9c,
-	0x1e, 0xc5, 0xa4, 0xef, 0x62, 0xe2, 0xfb, 0x2c, 0x22, 0x91, 0xcb, 0x7c, 0x15, 0xad, 0x38, 0xcc,
-	0x61, 0xfc, 0x11, 0x4f, 0x9e, 0xe4, 0xee, 0x7a, 0x87, 0x85, 0x1e, 0x0b, 0x8f, 0x44, 0x40, 0x2c,
-	0x54, 0x48, 0xca, 0xf1, 0x95, 0x3d, 0x7c, 0x86, 0x89, 0x2f, 0xb1, 0xcd, 0x0a, 0xa0, 0xcf, 0x26,
-	0x53, 0x3c, 0x21, 0x01, 0xf1, 0xc2, 0x36, 0x1d, 0x0c, 0x69, 0x18, 0x99, 0x8f, 0xa1, 0x3c, 0xb3,
-	0x1b, 0xf6, 0x99, 0x1f, 0x52, 0x74, 0x17, 0x8a, 0x7d, 0xbe, 0x73, 0x5d, 0xdb, 0xd4, 0xb6, 0x4b,
-	0xcd, 0x35, 0x2b, 0x31, 0xb4, 0x25, 0x0a, 0x5a, 0x17, 0x9e, 0xff, 0xbd, 0x51, 0x68, 0xcb, 0x64,
-	0xf3, 0x3d, 0xd8, 0xe2, 0x6a, 0x87, 0x51, 0x44, 0x43, 0x31, 0x8a, 0x6c, 0xd4, 0x1a, 0x7f, 0xc2,
-	0xfc, 0x0e, 0x95, 0x2b, 0x54, 0x81, 0x8b, 0xfe, 0x64, 0xcd, 0xe5, 0x2f, 0xb4, 0xc5, 0xc2, 0x1c,
-	0x43, 0x63, 0x51, 0xb9, 0xe4, 0xfb, 0x14, 0x4a, 0xe4, 0x2c, 0x49, 0x42, 0x56, 0x2c, 0x31, 0xbd,
-	0xa5, 0xa6, 0xb7, 0x0e, 0xfd, 0x71, 0x6b, 0xed, 0xc5, 0xaf, 0xbb, 0xe5, 0x79, 0xc5, 0x8f, 0xda,
-	0x71, 0x05, 0xb3, 0x0e, 0x26, 0x6f, 0xfd, 0x98, 0x4c, 0xf6, 0x62, 0xe9, 0x71, 0x6c, 0xf3, 0x3e,
-	0xd4, 0x72, 0xb3, 0x24, 0x5d, 0xfa, 0x74, 0x0d, 0xa8, 0xf3, 0xe2, 0x87, 0x24, 0xe8, 0xb9, 0x39,
-	0x4d, 0xd4, 0x4b, 0xcc, 0xce, 0xcb, 0x6d, 0xd3, 0x82, 0x37, 0x63, 0x8c, 0x4f, 0x49, 0x2f, 0xa4,
-	0x91, 0x7a, 0x8b, 0xf4, 0x19, 0x0b, 0xe8, 0x12, 0x3f, 0xc4, 0x97, 0xb0, 0xb3, 0x94, 0x86, 0x04,
-	0xc1, 0x50, 0x1c, 0xf1, 0x9c, 0xcc, 0xd3, 0x22, 0x25, 0x64, 0x9a, 0x59, 0x83, 0x5b, 0x31, 0xfd,
-	0xcf, 0x7d, 0x9b, 0xf9, 0x5d, 0xd7, 0x77, 0x1e, 0x51, 0xd7, 0x39, 0x56, 0x8d, 0xcc, 0x07, 0x33,
-	0x3f, 0xc9, 0x5c, 0x92, 0xec, 0x5d, 0x85, 0xe2, 0x31, 0xdf, 0x91, 0x13, 0xc8, 0x95, 0x69, 0xc2,
-	0x66, 0xac, 0xfa, 0x03, 0x12, 0x91, 0xf7, 0x99, 0xe7, 0xb9, 0x91, 0x47, 0xfd, 0x69, 0x07, 0x6f,
-	0x06, 0x23, 0x99, 0x23, 0x1b, 0x3c, 0x82, 0xcb, 0x5d, 0x12, 0x91, 0xa3, 0xce, 0x34, 0x24, 0xa7,
-	0xdc, 0x98, 0x9b, 0x32, 0xa1, 0x70, 0xa9, 0x3b, 0xb3, 0x36, 0x5b, 0xb0, 0xcd, 0xdb, 0x25, 0xd2,
-	0x88, 0xef, 0xd0, 0x0f, 0x59, 0x30, 0x33, 0x7c, 0xe6, 0x58, 0x43, 0xb8, 0xb3, 0x84, 0xc6, 0x2b,
-	0x47, 0x7f, 0x08, 0x55, 0x71, 0x26, 0x9f, 0x7e, 0x7c, 0xd8, 0xed, 0x06, 0x34, 0x54, 0x06, 0x82,
-	0x76, 0xe0, 0xea, 0x88, 0xf4, 0xdc, 0x2e, 0x89, 0x58, 0x70, 0x44, 0x44, 0x8c, 0x77, 0x79, 0xbd,
-	0x7d, 0x65, 0x1a, 0x90, 0x35, 0xe6, 0x3d, 0x58, 0x9b, 0x93, 0x91, 0xac, 0x1b, 0x50, 0xa2, 0x23,
-	0x2f, 0xa1, 0x00, 0x74, 0xe4, 0xc9, 0xc4, 0xe6, 0x7f, 0x25, 0xb8, 0xc8, 0x8b, 0xd1, 0x57, 0x50,
-	0x14, 0xee, 0x83, 0x6a, 0x73, 0x73, 0xcc, 0x5b, 0x9c, 0x5e, 0xcf, 0x4f, 0x12, 0xfd, 0xcd, 0xea,
-	0xf7, 0x7f, 0xfe, 0xfb, 0xe3, 0x6b, 0x57, 0xd0, 0x25, 0xe5, 0xd2, 0xc2, 0xd2, 0xd0, 0xef, 0x1a,
-	0xac, 0x67, 0xfa, 0x11, 0x3a, 0x48, 0xd7, 0x5e, 0xe4, 0x7f, 0xfa, 0x3b, 0x2b, 0xd7, 0x49, 0xcc,
-	0x5d, 0x8e, 0x79, 0x1b, 0x6d, 0x29, 0xcc, 0x98, 0x89, 0x85, 0x38, 0x10, 0x45, 0x21, 0xfe, 0x86,
-	0x7f, 0xc7, 0xdf, 0xa1, 0x5f, 0x34, 0xa8, 0xa6, 0x9b, 0x15, 0xda, 0x4f, 0x47, 0xc8, 0x35, 0x40,
-	0xfd, 0xed, 0xd5, 0x8a, 0x24, 0xf4, 0x1d, 0x0e, 0x5d, 0x43, 0xb7, 0x52, 0xa1, 0x39, 0x2a, 0xee,
-	0x71, 0x09, 0xf4, 0x9b, 0x06, 0xd7, 0xb3, 0x8c, 0x0f, 0xdd, 0x4d, 0xef, 0xbe, 0xc0, 0x50, 0xf5,
-	0x83, 0x55, 0xcb, 0x24, 0xf6, 0x0e, 0xc7, 0xde, 0x42, 0xb5, 0x1c, 0x6c, 0x2a, 0x45, 0xd0, 0x0b,
-	0x0d, 0x8c, 0x7c, 0xbb, 0x44, 0xf7, 0xf3, 0x5e, 0xde, 0x02, 0xa3, 0xd6, 0x1f, 0x9c, 0xaf, 0x38,
-	0xeb, 0xd8, 0x08, 0x23, 0x56, 0x07, 0x06, 0xdb, 0xbc, 0x66, 0x7a, 0x6c, 0x7e, 0xd2, 0xe0, 0x5a,
-	0xaa, 0xed, 0xa2, 0x66, 0x1e, 0x46, 0xba, 0x91, 0xeb, 0xfb, 0x2b, 0xd5, 0x48, 0xe2, 0x75, 0x4e,
-	0x5c, 0x46, 0x57, 0x15, 0xf1, 0x50, 0x25, 0xa2, 0x3f, 0x34, 0xb8, 0x99, 0xe7, 0x7f, 0xe8, 0xdd,
-	0xf4, 0x86, 0x4b, 0xf8, 0xae, 0x7e, 0xef, 0x3c, 0xa5, 0x12, 0xf9, 0x2d, 0x8e, 0xdc, 0x40, 0x75,
-	0x85, 0x9c, 0x30, 0x5f, 0x1c, 0x4c, 0xea, 0xb0, 0x70, 0x72, 0xf4, 0xb3, 0x06, 0x95, 0xb4, 0x8b,
-	0x07, 0xed, 0xe5, 0xbd, 0xae, 0xd4, 0x8b, 0x4c, 0x6f, 0xae, 0x52, 0x22, 0x69, 0x1b, 0x9c, 0x76,
-	0x13, 0x19, 0x59, 0xb4, 0xf2, 0x8b, 0xfc, 0x16, 0xe0, 0xcc, 0xae, 0xd1, 0xed, 0x8c, 0x6f, 0x29,
-	0x79, 0x2f, 0xe8, 0xdb, 0x8b, 0x13, 0x25, 0xc8, 0x0d, 0x0e, 0x72, 0x0d, 0x95, 0x15, 0x48, 0xec,
-	0x1e, 0x68, 0x3d, 0x79, 0x7e, 0x62, 0x68, 0x2f, 0x4f, 0x0c, 0xed, 0x9f, 0x13, 0x43, 0xfb, 0xe1,
-	0xd4, 0x28, 0xbc, 0x3c, 0x35, 0x0a, 0x7f, 0x9d, 0x1a, 0x85, 0x2f, 0x0e, 0x1c, 0x37, 0x3a, 0x1e,
-	0xda, 0x56, 0x87, 0x79, 0x58, 0xb5, 0x62, 0x81, 0x33, 0x7d, 0xde, 0x25, 0xfd, 0x3e, 0xfe, 0x1a,
-	0xdb, 0x3d, 0x66, 0x87, 0x51, 0x40, 0x89, 0x27, 0xfe, 0x77, 0xdb, 0x45, 0xfe, 0xef, 0x70, 0xff,
-	0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x42, 0x6c, 0x12, 0x89, 0xe4, 0x0b, 0x00, 0x00,
+func (m *QueryDataCommitmentRootRequest) Reset()         { *m = QueryDataCommitmentRootRequest{} }
+func (m *QueryDataCommitmentRootRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataCommitmentRootRequest) ProtoMessage()    {}
+func (*QueryDataCommitmentRootRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{22}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the current parameters for the blobstream module
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// AttestationRequestByNonce queries attestation request by nonce.
-	// Returns nil if not found.
-	AttestationRequestByNonce(ctx context.Context, in *QueryAttestationRequestByNonceRequest, opts ...grpc.CallOption) (*QueryAttestationRequestByNonceResponse, error)
-	// LatestAttestationNonce queries latest attestation nonce.
-	LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error)
-	// EarliestAttestationNonce queries the earliest attestation nonce.
-	EarliestAttestationNonce(ctx context.Context, in *QueryEarliestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryEarliestAttestationNonceResponse, error)
-	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
-	// And, even if the current nonce is a valset, it will return the previous
-	// one.
-	// If the provided nonce is 1, it will return an error, because, there is
-	// no valset before nonce 1.
-	LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error)
-	// LatestUnbondingHeight returns the latest unbonding height
-	LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error)
-	// DataCommitmentRangeForHeight returns the data commitment window
-	// that includes the provided height
-	DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error)
-	// LatestDataCommitment returns the latest data commitment in store
-	LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error)
-	// EVMAddress returns the evm address associated with a supplied
-	// validator address
-	EVMAddress(ctx context.Context, in *QueryEVMAddressRequest, opts ...grpc.CallOption) (*QueryEVMAddressResponse, error)
+func (m *QueryDataCommitmentRootRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryDataCommitmentRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataCommitmentRootRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryDataCommitmentRootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataCommitmentRootRequest.Merge(m, src)
+}
+func (m *QueryDataCommitmentRootRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataCommitmentRootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataCommitmentRootRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDataCommitmentRootRequest proto.InternalMessageInfo
+
+func (m *QueryDataCommitmentRootRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) AttestationRequestByNonce(ctx context.Context, in *QueryAttestationRequestByNonceRequest, opts ...grpc.CallOption) (*QueryAttestationRequestByNonceResponse, error) {
-	out := new(QueryAttestationRequestByNonceResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/AttestationRequestByNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDataCommitmentRootResponse
+type QueryDataCommitmentRootResponse struct {
+	DataCommitment *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
+	// data_root_tuple_root is the merkle root of the data root tuples of the
+	// blocks in the data commitment range.
+	DataRootTupleRoot []byte `protobuf:"bytes,2,opt,name=data_root_tuple_root,json=dataRootTupleRoot,proto3" json:"data_root_tuple_root,omitempty"`
 }
 
-func (c *queryClient) LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error) {
-	out := new(QueryLatestAttestationNonceResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestAttestationNonce", in, out, opts...)
+func (m *QueryDataCommitmentRootResponse) Reset()         { *m = QueryDataCommitmentRootResponse{} }
+func (m *QueryDataCommitmentRootResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataCommitmentRootResponse) ProtoMessage()    {}
+func (*QueryDataCommitmentRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{23}
+}
+func (m *QueryDataCommitmentRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataCommitmentRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataCommitmentRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataCommitmentRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataCommitmentRootResponse.Merge(m, src)
+}
+func (m *QueryDataCommitmentRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataCommitmentRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataCommitmentRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataCommitmentRootResponse proto.InternalMessageInfo
+
+func (m *QueryDataCommitmentRootResponse) GetDataCommitment() *DataCommitment {
+	if m != nil {
+		return m.DataCommitment
+	}
+	return nil
+}
+
+func (m *QueryDataCommitmentRootResponse) GetDataRootTupleRoot() []byte {
+	if m != nil {
+		return m.DataRootTupleRoot
+	}
+	return nil
+}
+
+// QueryDataRootTupleInclusionProofRequest
+type QueryDataRootTupleInclusionProofRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryDataRootTupleInclusionProofRequest) Reset() {
+	*m = QueryDataRootTupleInclusionProofRequest{}
+}
+func (m *QueryDataRootTupleInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataRootTupleInclusionProofRequest) ProtoMessage()    {}
+func (*QueryDataRootTupleInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{24}
+}
+func (m *QueryDataRootTupleInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataRootTupleInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataRootTupleInclusionProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataRootTupleInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataRootTupleInclusionProofRequest.Merge(m, src)
+}
+func (m *QueryDataRootTupleInclusionProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataRootTupleInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataRootTupleInclusionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataRootTupleInclusionProofRequest proto.InternalMessageInfo
+
+func (m *QueryDataRootTupleInclusionProofRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryDataRootTupleInclusionProofResponse
+type QueryDataRootTupleInclusionProofResponse struct {
+	DataCommitment *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
+	// data_root is the data root of the block at the requested height.
+	DataRoot []byte `protobuf:"bytes,2,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	// data_root_tuple_root is the merkle root of the data root tuples of the
+	// blocks in the data commitment range.
+	DataRootTupleRoot []byte `protobuf:"bytes,3,opt,name=data_root_tuple_root,json=dataRootTupleRoot,proto3" json:"data_root_tuple_root,omitempty"`
+	// proof is the merkle proof of the data root tuple to the data root tuple
+	// root.
+	Proof crypto.Proof `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof"`
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) Reset() {
+	*m = QueryDataRootTupleInclusionProofResponse{}
+}
+func (m *QueryDataRootTupleInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataRootTupleInclusionProofResponse) ProtoMessage()    {}
+func (*QueryDataRootTupleInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{25}
+}
+func (m *QueryDataRootTupleInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataRootTupleInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataRootTupleInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataRootTupleInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataRootTupleInclusionProofResponse.Merge(m, src)
+}
+func (m *QueryDataRootTupleInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataRootTupleInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataRootTupleInclusionProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataRootTupleInclusionProofResponse proto.InternalMessageInfo
+
+func (m *QueryDataRootTupleInclusionProofResponse) GetDataCommitment() *DataCommitment {
+	if m != nil {
+		return m.DataCommitment
+	}
+	return nil
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) GetDataRootTupleRoot() []byte {
+	if m != nil {
+		return m.DataRootTupleRoot
+	}
+	return nil
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) GetProof() crypto.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return crypto.Proof{}
+}
+
+func init() {
+	proto.RegisterEnum("celestia.qgb.v1.AttestationType", AttestationType_name, AttestationType_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.qgb.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.qgb.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAttestationRequestByNonceRequest)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceRequest")
+	proto.RegisterType((*QueryAttestationRequestByNonceResponse)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceResponse")
+	proto.RegisterType((*QueryLatestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceRequest")
+	proto.RegisterType((*QueryLatestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceResponse")
+	proto.RegisterType((*QueryEarliestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceRequest")
+	proto.RegisterType((*QueryEarliestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceResponse")
+	proto.RegisterType((*QueryAttestationsRequest)(nil), "celestia.qgb.v1.QueryAttestationsRequest")
+	proto.RegisterType((*QueryAttestationsResponse)(nil), "celestia.qgb.v1.QueryAttestationsResponse")
+	proto.RegisterType((*QueryValsetsInRangeRequest)(nil), "celestia.qgb.v1.QueryValsetsInRangeRequest")
+	proto.RegisterType((*QueryValsetsInRangeResponse)(nil), "celestia.qgb.v1.QueryValsetsInRangeResponse")
+	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceRequest)(nil), "celestia.qgb.v1.QueryLatestValsetRequestBeforeNonceRequest")
+	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceResponse)(nil), "celestia.qgb.v1.QueryLatestValsetRequestBeforeNonceResponse")
+	proto.RegisterType((*QueryLatestUnbondingHeightRequest)(nil), "celestia.qgb.v1.QueryLatestUnbondingHeightRequest")
+	proto.RegisterType((*QueryLatestUnbondingHeightResponse)(nil), "celestia.qgb.v1.QueryLatestUnbondingHeightResponse")
+	proto.RegisterType((*QueryLatestDataCommitmentRequest)(nil), "celestia.qgb.v1.QueryLatestDataCommitmentRequest")
+	proto.RegisterType((*QueryLatestDataCommitmentResponse)(nil), "celestia.qgb.v1.QueryLatestDataCommitmentResponse")
+	proto.RegisterType((*QueryDataCommitmentRangeForHeightRequest)(nil), "celestia.qgb.v1.QueryDataCommitmentRangeForHeightRequest")
+	proto.RegisterType((*QueryDataCommitmentRangeForHeightResponse)(nil), "celestia.qgb.v1.QueryDataCommitmentRangeForHeightResponse")
+	proto.RegisterType((*QueryEVMAddressRequest)(nil), "celestia.qgb.v1.QueryEVMAddressRequest")
+	proto.RegisterType((*QueryEVMAddressResponse)(nil), "celestia.qgb.v1.QueryEVMAddressResponse")
+	proto.RegisterType((*QueryDataCommitmentRootRequest)(nil), "celestia.qgb.v1.QueryDataCommitmentRootRequest")
+	proto.RegisterType((*QueryDataCommitmentRootResponse)(nil), "celestia.qgb.v1.QueryDataCommitmentRootResponse")
+	proto.RegisterType((*QueryDataRootTupleInclusionProofRequest)(nil), "celestia.qgb.v1.QueryDataRootTupleInclusionProofRequest")
+	proto.RegisterType((*QueryDataRootTupleInclusionProofResponse)(nil), "celestia.qgb.v1.QueryDataRootTupleInclusionProofResponse")
+}
+
+func init() { proto.RegisterFile("celestia/qgb/v1/query.proto", fileDescriptor_c8535c57355a2b91) }
+
+var fileDescriptor_c8535c57355a2b91 = []byte{
+	// 1451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0x92, 0x90, 0x2f, 0x79, 0x41, 0x21, 0x4c, 0x4c, 0xe2, 0x6c, 0x12, 0xc7, 0xd9, 0x84,
+	0x10, 0x08, 0xec, 0x7e, 0x09, 0x10, 0xca, 0x8f, 0x4a, 0x75, 0xc0, 0x14, 0x4b, 0x24, 0x04, 0x63,
+	0x22, 0x95, 0x43, 0xad, 0xb5, 0x3d, 0x59, 0x56, 0xf5, 0xee, 0x38, 0xbb, 0xe3, 0x08, 0x8b, 0x72,
+	0xa1, 0xaa, 0xd4, 0x22, 0x55, 0xaa, 0xda, 0x63, 0xcb, 0xa9, 0xe2, 0xda, 0x53, 0xd5, 0x4b, 0x6f,
+	0xed, 0x05, 0x71, 0x42, 0xea, 0xa5, 0xea, 0xa1, 0xaa, 0xa0, 0x7f, 0x44, 0x8f, 0xd5, 0xce, 0xcc,
+	0x3a, 0xf6, 0x7a, 0x77, 0xed, 0x20, 0x6e, 0x9e, 0x79, 0xef, 0x7d, 0xde, 0xe7, 0xbd, 0x79, 0x33,
+	0xfb, 0x49, 0x60, 0xaa, 0x8c, 0xab, 0xd8, 0xa5, 0xa6, 0xae, 0xed, 0x18, 0x25, 0x6d, 0xf7, 0xac,
+	0xb6, 0x53, 0xc7, 0x4e, 0x43, 0xad, 0x39, 0x84, 0x12, 0x74, 0xc4, 0x37, 0xaa, 0x3b, 0x46, 0x49,
+	0xdd, 0x3d, 0x2b, 0xcf, 0x04, 0xbd, 0x0d, 0x6c, 0x63, 0xd7, 0x74, 0xb9, 0xbf, 0xdc, 0x01, 0x46,
+	0x1b, 0x35, 0xec, 0x1b, 0xa7, 0x0d, 0x42, 0x8c, 0x2a, 0xd6, 0xf4, 0x9a, 0xa9, 0xe9, 0xb6, 0x4d,
+	0xa8, 0x4e, 0x4d, 0x62, 0xfb, 0xd6, 0x84, 0x41, 0x0c, 0xc2, 0x7e, 0x6a, 0xde, 0x2f, 0xb1, 0x3b,
+	0x59, 0x26, 0xae, 0x45, 0xdc, 0x22, 0x37, 0xf0, 0x85, 0x6f, 0x12, 0x70, 0x6c, 0x55, 0xaa, 0x6f,
+	0x6b, 0xba, 0x2d, 0x68, 0xcb, 0x33, 0x14, 0xdb, 0x15, 0xec, 0x58, 0xa6, 0x4d, 0xb5, 0xb2, 0xd3,
+	0xa8, 0x51, 0xe2, 0x79, 0x91, 0x6d, 0x6e, 0x56, 0x12, 0x80, 0xee, 0x78, 0x45, 0x6e, 0xea, 0x8e,
+	0x6e, 0xb9, 0x79, 0xbc, 0x53, 0xc7, 0x2e, 0x55, 0x6e, 0xc1, 0x58, 0xdb, 0xae, 0x5b, 0x23, 0xb6,
+	0x8b, 0xd1, 0x05, 0x18, 0xac, 0xb1, 0x9d, 0xa4, 0x94, 0x96, 0x96, 0x86, 0x57, 0x26, 0xd4, 0x40,
+	0x4f, 0x54, 0x1e, 0xb0, 0x36, 0xf0, 0xe2, 0xaf, 0xd9, 0xbe, 0xbc, 0x70, 0x56, 0xde, 0x87, 0xe3,
+	0x0c, 0x2d, 0x43, 0x29, 0x76, 0x79, 0xa5, 0x22, 0xd1, 0x5a, 0x63, 0x83, 0xd8, 0x65, 0x2c, 0x56,
+	0x28, 0x01, 0x07, 0x6d, 0x6f, 0xcd, 0xe0, 0x07, 0xf2, 0x7c, 0xa1, 0x34, 0x60, 0xb1, 0x5b, 0xb8,
+	0xe0, 0x77, 0x1b, 0x86, 0xf5, 0x3d, 0x27, 0x41, 0x32, 0xa1, 0xf2, 0xe6, 0xa8, 0x7e, 0x73, 0xd4,
+	0x8c, 0xdd, 0x58, 0x9b, 0x78, 0xf9, 0xd3, 0x99, 0xb1, 0x4e, 0xc4, 0x5c, 0xbe, 0x15, 0x41, 0x59,
+	0x00, 0x85, 0xa5, 0xbe, 0xa5, 0x7b, 0x7b, 0x2d, 0xee, 0xad, 0xb4, 0x95, 0x2b, 0x30, 0x1f, 0xeb,
+	0x25, 0xd8, 0x85, 0x57, 0xb7, 0x08, 0x0b, 0x2c, 0x38, 0xab, 0x3b, 0x55, 0x33, 0x26, 0x89, 0xdf,
+	0xc4, 0x68, 0xbf, 0xd8, 0x34, 0xdf, 0x48, 0x90, 0x0c, 0x76, 0xd1, 0x3f, 0x6e, 0x34, 0x03, 0xb0,
+	0xed, 0x10, 0xab, 0xd8, 0x1a, 0x37, 0xe4, 0xed, 0x30, 0x64, 0x0f, 0xb1, 0x6a, 0x5a, 0x26, 0x4d,
+	0x1e, 0xe0, 0x88, 0x6c, 0x81, 0x32, 0x30, 0xec, 0x4d, 0x74, 0x71, 0xdb, 0xac, 0x52, 0xec, 0x24,
+	0xfb, 0xd3, 0xd2, 0xd2, 0xc8, 0x4a, 0xba, 0x63, 0x22, 0x5a, 0xf2, 0x15, 0x1a, 0x35, 0x9c, 0x07,
+	0x2f, 0xe8, 0x06, 0x8b, 0x51, 0xbe, 0x92, 0x60, 0x32, 0x84, 0x94, 0x28, 0xe4, 0x0e, 0x1c, 0x6e,
+	0x39, 0x0b, 0x6f, 0xe6, 0xfa, 0xf7, 0x7f, 0x9c, 0x6d, 0x10, 0x5e, 0xa1, 0x36, 0x7e, 0x48, 0x45,
+	0xa1, 0xbc, 0x9c, 0x21, 0x6f, 0x87, 0x15, 0xaa, 0xdc, 0x07, 0x99, 0xd1, 0xd9, 0xd2, 0xab, 0x2e,
+	0xa6, 0x6e, 0xce, 0xce, 0xeb, 0xb6, 0xd1, 0x9c, 0xce, 0x59, 0x18, 0x2e, 0x61, 0xc3, 0xb4, 0xdb,
+	0xda, 0x04, 0x6c, 0x8b, 0xf7, 0x69, 0x0a, 0x86, 0xb0, 0x5d, 0x69, 0x03, 0x3f, 0x84, 0xed, 0x0a,
+	0xc7, 0xde, 0x82, 0xa9, 0x50, 0x6c, 0x51, 0xec, 0x45, 0xf8, 0xdf, 0x2e, 0xb7, 0x88, 0x3a, 0x3b,
+	0xef, 0x16, 0x8f, 0x14, 0x77, 0xcb, 0xf7, 0x56, 0xd6, 0xe0, 0x54, 0xcb, 0xf0, 0x71, 0x1f, 0xff,
+	0x7a, 0xe0, 0x6d, 0xe2, 0xe0, 0x1e, 0x6e, 0xd8, 0xc7, 0xb0, 0xdc, 0x13, 0x86, 0xe0, 0xaa, 0xc1,
+	0x20, 0xcf, 0x1e, 0xf9, 0x0c, 0x08, 0x08, 0xe1, 0xa6, 0xcc, 0xc3, 0x5c, 0x0b, 0xfe, 0x3d, 0xbb,
+	0x44, 0xec, 0x8a, 0x69, 0x1b, 0x37, 0xb1, 0x69, 0x3c, 0xf0, 0x13, 0x29, 0x57, 0x41, 0x89, 0x73,
+	0x12, 0xb9, 0xc7, 0x61, 0xf0, 0x01, 0xdb, 0x11, 0x15, 0x88, 0x95, 0xa2, 0x40, 0xba, 0x25, 0xfa,
+	0xba, 0x4e, 0xf5, 0x6b, 0xc4, 0xb2, 0x4c, 0x6a, 0x61, 0xbb, 0x99, 0xc1, 0x82, 0xb9, 0x18, 0x1f,
+	0x91, 0xe0, 0x26, 0x1c, 0xa9, 0xe8, 0x54, 0x2f, 0x96, 0x9b, 0x26, 0x51, 0xe5, 0x6c, 0x47, 0x95,
+	0x01, 0x84, 0x91, 0x4a, 0xdb, 0x5a, 0x59, 0x83, 0x25, 0x96, 0x2e, 0xe0, 0xe6, 0x1d, 0xfb, 0x0d,
+	0xe2, 0xb4, 0x15, 0x1f, 0x59, 0x56, 0x1d, 0x4e, 0xf6, 0x80, 0xf1, 0xce, 0xa9, 0x67, 0x61, 0x9c,
+	0x3f, 0x36, 0x5b, 0xeb, 0x99, 0x4a, 0xc5, 0xc1, 0x6e, 0xf3, 0xa9, 0x58, 0x86, 0xa3, 0xbb, 0x7a,
+	0xd5, 0xac, 0xe8, 0x94, 0x38, 0x45, 0x9d, 0xdb, 0x58, 0x96, 0xa1, 0xfc, 0x68, 0xd3, 0x20, 0x62,
+	0x94, 0xcb, 0x30, 0xd1, 0x01, 0x23, 0xb8, 0xce, 0xc2, 0x30, 0xde, 0xb5, 0x02, 0x08, 0x80, 0x77,
+	0x2d, 0x3f, 0x76, 0x15, 0x52, 0x61, 0x95, 0x13, 0x42, 0xe3, 0x67, 0xf9, 0x7b, 0x09, 0x66, 0x23,
+	0x03, 0xdf, 0x75, 0xa3, 0x90, 0x06, 0x09, 0x86, 0xe4, 0x10, 0x42, 0x8b, 0xb4, 0x5e, 0xab, 0x62,
+	0xf6, 0x93, 0xdd, 0xfe, 0xc3, 0xf9, 0xa3, 0x9e, 0xcd, 0xcb, 0x5c, 0xf0, 0x2c, 0xde, 0x0f, 0x25,
+	0x03, 0x27, 0x9a, 0xec, 0x9a, 0x96, 0x9c, 0x5d, 0xae, 0xd6, 0x5d, 0x93, 0xd8, 0x9b, 0xde, 0x97,
+	0xb9, 0xdb, 0x4c, 0xfc, 0x2b, 0xc1, 0x52, 0x77, 0x8c, 0x77, 0x5e, 0xea, 0x14, 0x0c, 0x35, 0x4b,
+	0x15, 0xf5, 0x1d, 0xf2, 0xeb, 0x8b, 0xec, 0x43, 0x7f, 0x44, 0x1f, 0xd0, 0x79, 0x38, 0xc8, 0x64,
+	0x48, 0x72, 0x80, 0xb1, 0x49, 0xaa, 0x7b, 0x32, 0x45, 0xe5, 0x32, 0x45, 0x65, 0x85, 0x88, 0xe7,
+	0x8e, 0x3b, 0x9f, 0xfa, 0x53, 0x82, 0x23, 0x81, 0x0f, 0x0a, 0xfa, 0x00, 0xa6, 0x33, 0x85, 0x42,
+	0xf6, 0x6e, 0x21, 0x53, 0xc8, 0xdd, 0xde, 0x28, 0x16, 0x3e, 0xda, 0xcc, 0x16, 0xef, 0x6d, 0xdc,
+	0xdd, 0xcc, 0x5e, 0xcb, 0xdd, 0xc8, 0x65, 0xaf, 0x8f, 0xf6, 0xc9, 0xa9, 0xa7, 0xcf, 0xd2, 0x72,
+	0x20, 0xec, 0x9e, 0xed, 0xd6, 0x70, 0xd9, 0xdc, 0x36, 0x71, 0x05, 0xad, 0xc2, 0x44, 0x07, 0xc2,
+	0x56, 0xe6, 0xd6, 0xdd, 0x6c, 0x61, 0x54, 0x92, 0x27, 0x9f, 0x3e, 0x4b, 0x1f, 0x0b, 0x04, 0xf3,
+	0xe7, 0x0d, 0x7d, 0x08, 0xe9, 0x8e, 0xb8, 0xeb, 0x99, 0x42, 0xa6, 0x78, 0xed, 0xf6, 0xfa, 0x7a,
+	0xae, 0xb0, 0x9e, 0xdd, 0x28, 0x8c, 0x1e, 0x90, 0xe7, 0x9e, 0x3e, 0x4b, 0xcf, 0x04, 0x00, 0xda,
+	0x5b, 0x2d, 0x0f, 0x7c, 0xf1, 0x43, 0xaa, 0x6f, 0xe5, 0xf3, 0x11, 0x38, 0xc8, 0xce, 0x15, 0x7d,
+	0x02, 0x83, 0x5c, 0x48, 0xa1, 0xf9, 0x8e, 0x53, 0xea, 0x54, 0x6b, 0xf2, 0x42, 0xbc, 0x13, 0x9f,
+	0x04, 0x65, 0xfc, 0xc9, 0xef, 0xff, 0x7c, 0x7b, 0x60, 0x14, 0x8d, 0xf8, 0x7a, 0x94, 0xab, 0x33,
+	0xf4, 0x8b, 0x04, 0x93, 0x91, 0xd2, 0x0a, 0xad, 0x86, 0x63, 0x77, 0x93, 0x72, 0xf2, 0xc5, 0x7d,
+	0xc7, 0x09, 0x9a, 0x67, 0x18, 0xcd, 0x13, 0xe8, 0xb8, 0x4f, 0xb3, 0xf5, 0x03, 0xae, 0x39, 0x3c,
+	0xc8, 0xd5, 0x1e, 0xb1, 0xdb, 0xfe, 0x18, 0xfd, 0x28, 0xc1, 0x78, 0xb8, 0xee, 0x42, 0xe7, 0xc2,
+	0x29, 0xc4, 0x6a, 0x39, 0xf9, 0xfc, 0xfe, 0x82, 0x04, 0xe9, 0x93, 0x8c, 0xf4, 0x3c, 0x9a, 0x0b,
+	0x25, 0xcd, 0xa8, 0x6a, 0x55, 0x06, 0x81, 0x7e, 0x96, 0x20, 0x19, 0xa5, 0xe1, 0xd0, 0x85, 0xf0,
+	0xec, 0x5d, 0xb4, 0xa1, 0xbc, 0xba, 0xdf, 0x30, 0x41, 0x7b, 0x99, 0xd1, 0x3e, 0x8e, 0xe6, 0x63,
+	0x68, 0x63, 0x01, 0x82, 0x3e, 0x93, 0xe0, 0x70, 0x0b, 0x92, 0x8b, 0x4e, 0x76, 0x3d, 0xe2, 0xe6,
+	0x84, 0x9e, 0xea, 0xc5, 0x55, 0x90, 0x9a, 0x66, 0xa4, 0xc6, 0x51, 0x22, 0x8c, 0x14, 0x7a, 0x29,
+	0x41, 0x2a, 0x5e, 0xa6, 0xa0, 0x2b, 0x71, 0x47, 0xd8, 0x45, 0x20, 0xc9, 0x57, 0xdf, 0x2e, 0x38,
+	0x6a, 0x78, 0xb9, 0x00, 0xf2, 0xc7, 0x56, 0x2b, 0xb1, 0x98, 0xe6, 0xf0, 0x7e, 0x29, 0xc1, 0x48,
+	0xbb, 0x1e, 0x44, 0xcb, 0xe1, 0xf9, 0x43, 0x15, 0xa9, 0x7c, 0xba, 0x37, 0xe7, 0xa8, 0xc6, 0xfa,
+	0xe4, 0x58, 0xe2, 0xef, 0x24, 0x38, 0x16, 0x2a, 0xbd, 0xd0, 0x4a, 0x5c, 0x4b, 0xc2, 0xc5, 0x9c,
+	0x7c, 0x6e, 0x5f, 0x31, 0x82, 0xe0, 0x24, 0x23, 0x38, 0x86, 0x8e, 0xfa, 0x04, 0xeb, 0xbe, 0x23,
+	0xfa, 0x4d, 0x82, 0xe9, 0x38, 0x0d, 0x84, 0x2e, 0x85, 0x27, 0xec, 0x41, 0x7b, 0xc9, 0x97, 0xdf,
+	0x26, 0x54, 0x50, 0x3e, 0xcd, 0x28, 0x2f, 0xa2, 0x05, 0x9f, 0x72, 0xe0, 0x63, 0xcb, 0x9b, 0xab,
+	0xf1, 0x2f, 0x37, 0x7a, 0x2e, 0x41, 0x22, 0x4c, 0x7c, 0xa2, 0xb3, 0x71, 0xed, 0x0a, 0x15, 0xb3,
+	0xf2, 0xca, 0x7e, 0x42, 0x04, 0xdb, 0x45, 0xc6, 0x36, 0x8d, 0x52, 0x51, 0x6c, 0xc5, 0x1b, 0xf5,
+	0x29, 0xc0, 0x9e, 0x64, 0x43, 0x27, 0x22, 0x5e, 0x97, 0xa0, 0x36, 0x94, 0x97, 0xba, 0x3b, 0x0a,
+	0x22, 0x53, 0x8c, 0xc8, 0x31, 0x34, 0xe6, 0x13, 0x69, 0xd1, 0x82, 0x2b, 0x4f, 0xfa, 0x61, 0xac,
+	0xbd, 0x00, 0xfe, 0x55, 0x7c, 0x2e, 0x01, 0xea, 0x14, 0x75, 0x48, 0xeb, 0xe9, 0xf8, 0xf6, 0x74,
+	0xa3, 0xfc, 0xff, 0xde, 0x03, 0x7a, 0x3e, 0x65, 0x42, 0x68, 0xf3, 0x56, 0xff, 0x2a, 0xc1, 0x54,
+	0x8c, 0x34, 0x43, 0xef, 0x45, 0xe7, 0x8f, 0x57, 0x84, 0xf2, 0xa5, 0xb7, 0x88, 0x14, 0x25, 0xa8,
+	0xac, 0x84, 0x25, 0xb4, 0x18, 0x55, 0x02, 0x13, 0x58, 0xda, 0x23, 0x3e, 0xa9, 0x8f, 0xd7, 0x36,
+	0x5f, 0xbc, 0x4e, 0x49, 0xaf, 0x5e, 0xa7, 0xa4, 0xbf, 0x5f, 0xa7, 0xa4, 0xaf, 0xdf, 0xa4, 0xfa,
+	0x5e, 0xbd, 0x49, 0xf5, 0xfd, 0xf1, 0x26, 0xd5, 0x77, 0x7f, 0xd5, 0x30, 0xe9, 0x83, 0x7a, 0x49,
+	0x2d, 0x13, 0x4b, 0xf3, 0xe9, 0x10, 0xc7, 0x68, 0xfe, 0x3e, 0xa3, 0xd7, 0x6a, 0xda, 0x43, 0xad,
+	0x54, 0x25, 0x25, 0x97, 0x3a, 0x58, 0xb7, 0xf8, 0x3f, 0xbe, 0x4a, 0x83, 0xec, 0x0f, 0xf6, 0x73,
+	0xff, 0x0d, 0x00, 0x10, 0xca, 0x6f, 0xb8, 0x65, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the current parameters for the blobstream module
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AttestationRequestByNonce queries attestation request by nonce.
+	// Returns nil if not found.
+	AttestationRequestByNonce(ctx context.Context, in *QueryAttestationRequestByNonceRequest, opts ...grpc.CallOption) (*QueryAttestationRequestByNonceResponse, error)
+	// LatestAttestationNonce queries latest attestation nonce.
+	LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error)
+	// EarliestAttestationNonce queries the earliest attestation nonce.
+	EarliestAttestationNonce(ctx context.Context, in *QueryEarliestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryEarliestAttestationNonceResponse, error)
+	// Attestations queries the attestations starting at from_nonce, in
+	// increasing nonce order, optionally filtered by type. The next_nonce of the
+	// response can be used as the from_nonce of the following page.
+	Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error)
+	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
+	// And, even if the current nonce is a valset, it will return the previous
+	// one.
+	// If the provided nonce is 1, it will return an error, because, there is
+	// no valset before nonce 1.
+	LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error)
+	// ValsetsInRange queries the valsets with a nonce between begin_nonce and
+	// end_nonce, both inclusive.
+	ValsetsInRange(ctx context.Context, in *QueryValsetsInRangeRequest, opts ...grpc.CallOption) (*QueryValsetsInRangeResponse, error)
+	// LatestUnbondingHeight returns the latest unbonding height
+	LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error)
+	// DataCommitmentRangeForHeight returns the data commitment window
+	// that includes the provided height
+	DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error)
+	// LatestDataCommitment returns the latest data commitment in store
+	LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error)
+	// EVMAddress returns the evm address associated with a supplied
+	// validator address
+	EVMAddress(ctx context.Context, in *QueryEVMAddressRequest, opts ...grpc.CallOption) (*QueryEVMAddressResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationRequestByNonce(ctx context.Context, in *QueryAttestationRequestByNonceRequest, opts ...grpc.CallOption) (*QueryAttestationRequestByNonceResponse, error) {
+	out := new(QueryAttestationRequestByNonceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/AttestationRequestByNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error) {
+	out := new(QueryLatestAttestationNonceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestAttestationNonce", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -989,6 +1515,15 @@ func (c *queryClient) EarliestAttestationNonce(ctx context.Context, in *QueryEar
 	return out, nil
 }
 
+func (c *queryClient) Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error) {
+	out := new(QueryAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/Attestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
 	out := new(QueryLatestValsetRequestBeforeNonceResponse)
 	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestValsetRequestBeforeNonce", in, out, opts...)
@@ -998,6 +1533,15 @@ func (c *queryClient) LatestValsetRequestBeforeNonce(ctx context.Context, in *Qu
 	return out, nil
 }
 
+func (c *queryClient) ValsetsInRange(ctx context.Context, in *QueryValsetsInRangeRequest, opts ...grpc.CallOption) (*QueryValsetsInRangeResponse, error) {
+	out := new(QueryValsetsInRangeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/ValsetsInRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error) {
 	out := new(QueryLatestUnbondingHeightResponse)
 	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestUnbondingHeight", in, out, opts...)
@@ -1045,12 +1589,19 @@ type QueryServer interface {
 	LatestAttestationNonce(context.Context, *QueryLatestAttestationNonceRequest) (*QueryLatestAttestationNonceResponse, error)
 	// EarliestAttestationNonce queries the earliest attestation nonce.
 	EarliestAttestationNonce(context.Context, *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error)
+	// Attestations queries the attestations starting at from_nonce, in
+	// increasing nonce order, optionally filtered by type. The next_nonce of the
+	// response can be used as the from_nonce of the following page.
+	Attestations(context.Context, *QueryAttestationsRequest) (*QueryAttestationsResponse, error)
 	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
 	// And, even if the current nonce is a valset, it will return the previous
 	// one.
 	// If the provided nonce is 1, it will return an error, because, there is
 	// no valset before nonce 1.
 	LatestValsetRequestBeforeNonce(context.Context, *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error)
+	// ValsetsInRange queries the valsets with a nonce between begin_nonce and
+	// end_nonce, both inclusive.
+	ValsetsInRange(context.Context, *QueryValsetsInRangeRequest) (*QueryValsetsInRangeResponse, error)
 	// LatestUnbondingHeight returns the latest unbonding height
 	LatestUnbondingHeight(context.Context, *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error)
 	// DataCommitmentRangeForHeight returns the data commitment window
@@ -1079,9 +1630,15 @@ func (*UnimplementedQueryServer) LatestAttestationNonce(ctx context.Context, req
 func (*UnimplementedQueryServer) EarliestAttestationNonce(ctx context.Context, req *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EarliestAttestationNonce not implemented")
 }
+func (*UnimplementedQueryServer) Attestations(ctx context.Context, req *QueryAttestationsRequest) (*QueryAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestations not implemented")
+}
 func (*UnimplementedQueryServer) LatestValsetRequestBeforeNonce(ctx context.Context, req *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestValsetRequestBeforeNonce not implemented")
 }
+func (*UnimplementedQueryServer) ValsetsInRange(ctx context.Context, req *QueryValsetsInRangeRequest) (*QueryValsetsInRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValsetsInRange not implemented")
+}
 func (*UnimplementedQueryServer) LatestUnbondingHeight(ctx context.Context, req *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestUnbondingHeight not implemented")
 }
@@ -1171,6 +1728,24 @@ func _Query_EarliestAttestationNonce_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Attestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/Attestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestations(ctx, req.(*QueryAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LatestValsetRequestBeforeNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLatestValsetRequestBeforeNonceRequest)
 	if err := dec(in); err != nil {
@@ -1189,6 +1764,24 @@ func _Query_LatestValsetRequestBeforeNonce_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValsetsInRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValsetsInRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValsetsInRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/ValsetsInRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValsetsInRange(ctx, req.(*QueryValsetsInRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LatestUnbondingHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLatestUnbondingHeightRequest)
 	if err := dec(in); err != nil {
@@ -1281,10 +1874,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EarliestAttestationNonce",
 			Handler:    _Query_EarliestAttestationNonce_Handler,
 		},
+		{
+			MethodName: "Attestations",
+			Handler:    _Query_Attestations_Handler,
+		},
 		{
 			MethodName: "LatestValsetRequestBeforeNonce",
 			Handler:    _Query_LatestValsetRequestBeforeNonce_Handler,
 		},
+		{
+			MethodName: "ValsetsInRange",
+			Handler:    _Query_ValsetsInRange_Handler,
+		},
 		{
 			MethodName: "LatestUnbondingHeight",
 			Handler:    _Query_LatestUnbondingHeight_Handler,
@@ -1306,19 +1907,139 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 	Metadata: "celestia/qgb/v1/query.proto",
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// DataCommitmentQueryClient is the client API for DataCommitmentQuery service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DataCommitmentQueryClient interface {
+	// DataCommitmentRoot returns the data root tuple root of the data
+	// commitment with the provided nonce. This is the root that is signed by the
+	// orchestrators and relayed to the Blobstream contract.
+	DataCommitmentRoot(ctx context.Context, in *QueryDataCommitmentRootRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRootResponse, error)
+	// DataRootTupleInclusionProof returns the proof that the data root tuple of
+	// the provided height is included in the data root tuple root of the data
+	// commitment covering that height.
+	DataRootTupleInclusionProof(ctx context.Context, in *QueryDataRootTupleInclusionProofRequest, opts ...grpc.CallOption) (*QueryDataRootTupleInclusionProofResponse, error)
+}
+
+type dataCommitmentQueryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewDataCommitmentQueryClient(cc grpc1.ClientConn) DataCommitmentQueryClient {
+	return &dataCommitmentQueryClient{cc}
+}
+
+func (c *dataCommitmentQueryClient) DataCommitmentRoot(ctx context.Context, in *QueryDataCommitmentRootRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRootResponse, error) {
+	out := new(QueryDataCommitmentRootResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.DataCommitmentQuery/DataCommitmentRoot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataCommitmentQueryClient) DataRootTupleInclusionProof(ctx context.Context, in *QueryDataRootTupleInclusionProofRequest, opts ...grpc.CallOption) (*QueryDataRootTupleInclusionProofResponse, error) {
+	out := new(QueryDataRootTupleInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.DataCommitmentQuery/DataRootTupleInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataCommitmentQueryServer is the server API for DataCommitmentQuery service.
+type DataCommitmentQueryServer interface {
+	// DataCommitmentRoot returns the data root tuple root of the data
+	// commitment with the provided nonce. This is the root that is signed by the
+	// orchestrators and relayed to the Blobstream contract.
+	DataCommitmentRoot(context.Context, *QueryDataCommitmentRootRequest) (*QueryDataCommitmentRootResponse, error)
+	// DataRootTupleInclusionProof returns the proof that the data root tuple of
+	// the provided height is included in the data root tuple root of the data
+	// commitment covering that height.
+	DataRootTupleInclusionProof(context.Context, *QueryDataRootTupleInclusionProofRequest) (*QueryDataRootTupleInclusionProofResponse, error)
+}
+
+// UnimplementedDataCommitmentQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedDataCommitmentQueryServer struct {
+}
+
+func (*UnimplementedDataCommitmentQueryServer) DataCommitmentRoot(ctx context.Context, req *QueryDataCommitmentRootRequest) (*QueryDataCommitmentRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataCommitmentRoot not implemented")
+}
+func (*UnimplementedDataCommitmentQueryServer) DataRootTupleInclusionProof(ctx context.Context, req *QueryDataRootTupleInclusionProofRequest) (*QueryDataRootTupleInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataRootTupleInclusionProof not implemented")
+}
+
+func RegisterDataCommitmentQueryServer(s grpc1.Server, srv DataCommitmentQueryServer) {
+	s.RegisterService(&_DataCommitmentQuery_serviceDesc, srv)
+}
+
+func _DataCommitmentQuery_DataCommitmentRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataCommitmentRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCommitmentQueryServer).DataCommitmentRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.DataCommitmentQuery/DataCommitmentRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCommitmentQueryServer).DataCommitmentRoot(ctx, req.(*QueryDataCommitmentRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCommitmentQuery_DataRootTupleInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataRootTupleInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCommitmentQueryServer).DataRootTupleInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.DataCommitmentQuery/DataRootTupleInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCommitmentQueryServer).DataRootTupleInclusionProof(ctx, req.(*QueryDataRootTupleInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DataCommitmentQuery_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.qgb.v1.DataCommitmentQuery",
+	HandlerType: (*DataCommitmentQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DataCommitmentRoot",
+			Handler:    _DataCommitmentQuery_DataCommitmentRoot_Handler,
+		},
+		{
+			MethodName: "DataRootTupleInclusionProof",
+			Handler:    _DataCommitmentQuery_DataRootTupleInclusionProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/qgb/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
 func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
@@ -1527,6 +2248,156 @@ func (m *QueryEarliestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TypeFilter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TypeFilter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValsetsInRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValsetsInRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetsInRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BeginNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BeginNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValsetsInRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValsetsInRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetsInRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Valsets) > 0 {
+		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryLatestValsetRequestBeforeNonceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1822,91 +2693,248 @@ func (m *QueryEVMAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDataCommitmentRootRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryDataCommitmentRootRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAttestationRequestByNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDataCommitmentRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAttestationRequestByNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Attestation != nil {
-		l = m.Attestation.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDataCommitmentRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLatestAttestationNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryDataCommitmentRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLatestAttestationNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDataCommitmentRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
-	}
-	return n
-}
-
-func (m *QueryEarliestAttestationNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.DataRootTupleRoot) > 0 {
+		i -= len(m.DataRootTupleRoot)
+		copy(dAtA[i:], m.DataRootTupleRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRootTupleRoot)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	return n
-}
+	if m.DataCommitment != nil {
+		{
+			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataRootTupleInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataRootTupleInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataRootTupleInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DataRootTupleRoot) > 0 {
+		i -= len(m.DataRootTupleRoot)
+		copy(dAtA[i:], m.DataRootTupleRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRootTupleRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DataCommitment != nil {
+		{
+			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAttestationRequestByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryAttestationRequestByNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestAttestationNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestAttestationNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryEarliestAttestationNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
 
 func (m *QueryEarliestAttestationNonceResponse) Size() (n int) {
 	if m == nil {
@@ -1920,6 +2948,72 @@ func (m *QueryEarliestAttestationNonceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromNonce != 0 {
+		n += 1 + sovQuery(uint64(m.FromNonce))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	if m.TypeFilter != 0 {
+		n += 1 + sovQuery(uint64(m.TypeFilter))
+	}
+	return n
+}
+
+func (m *QueryAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextNonce != 0 {
+		n += 1 + sovQuery(uint64(m.NextNonce))
+	}
+	return n
+}
+
+func (m *QueryValsetsInRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeginNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BeginNonce))
+	}
+	if m.EndNonce != 0 {
+		n += 1 + sovQuery(uint64(m.EndNonce))
+	}
+	return n
+}
+
+func (m *QueryValsetsInRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryLatestValsetRequestBeforeNonceRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2036,16 +3130,606 @@ func (m *QueryEVMAddressResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryDataCommitmentRootRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryDataCommitmentRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		l = m.DataCommitment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DataRootTupleRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataRootTupleInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		l = m.DataCommitment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DataRootTupleRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &types.Any{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestAttestationNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestAttestationNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestAttestationNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestAttestationNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEarliestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEarliestAttestationNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEarliestAttestationNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEarliestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEarliestAttestationNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEarliestAttestationNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2068,12 +3752,69 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromNonce", wireType)
+			}
+			m.FromNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeFilter", wireType)
+			}
+			m.TypeFilter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TypeFilter |= AttestationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2095,7 +3836,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2118,15 +3859,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2153,10 +3894,30 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attestations = append(m.Attestations, &types.Any{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextNonce", wireType)
+			}
+			m.NextNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2178,7 +3939,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValsetsInRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2201,17 +3962,17 @@ func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValsetsInRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValsetsInRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginNonce", wireType)
 			}
-			m.Nonce = 0
+			m.BeginNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2221,7 +3982,26 @@ func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.BeginNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndNonce", wireType)
+			}
+			m.EndNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2247,7 +4027,7 @@ func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValsetsInRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2270,15 +4050,15 @@ func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValsetsInRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValsetsInRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2305,10 +4085,8 @@ func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attestation == nil {
-				m.Attestation = &types.Any{}
-			}
-			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Valsets = append(m.Valsets, Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2333,7 +4111,7 @@ func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestValsetRequestBeforeNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2356,12 +4134,31 @@ func (m *QueryLatestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestAttestationNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestAttestationNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2383,7 +4180,7 @@ func (m *QueryLatestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestValsetRequestBeforeNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2406,17 +4203,17 @@ func (m *QueryLatestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestAttestationNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestAttestationNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2426,11 +4223,28 @@ func (m *QueryLatestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Valset == nil {
+				m.Valset = &Valset{}
+			}
+			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2452,7 +4266,7 @@ func (m *QueryLatestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEarliestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestUnbondingHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2475,10 +4289,10 @@ func (m *QueryEarliestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEarliestAttestationNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestUnbondingHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEarliestAttestationNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestUnbondingHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2502,7 +4316,7 @@ func (m *QueryEarliestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEarliestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestUnbondingHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2525,17 +4339,17 @@ func (m *QueryEarliestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEarliestAttestationNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestUnbondingHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEarliestAttestationNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestUnbondingHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Nonce = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2545,7 +4359,7 @@ func (m *QueryEarliestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2571,7 +4385,7 @@ func (m *QueryEarliestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestValsetRequestBeforeNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestDataCommitmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2594,31 +4408,12 @@ func (m *QueryLatestValsetRequestBeforeNonceRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestDataCommitmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestDataCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2640,7 +4435,7 @@ func (m *QueryLatestValsetRequestBeforeNonceRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryLatestValsetRequestBeforeNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestDataCommitmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2663,15 +4458,15 @@ func (m *QueryLatestValsetRequestBeforeNonceResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestDataCommitmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestDataCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataCommitment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2698,10 +4493,10 @@ func (m *QueryLatestValsetRequestBeforeNonceResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Valset == nil {
-				m.Valset = &Valset{}
+			if m.DataCommitment == nil {
+				m.DataCommitment = &DataCommitment{}
 			}
-			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DataCommitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2726,7 +4521,7 @@ func (m *QueryLatestValsetRequestBeforeNonceResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryLatestUnbondingHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDataCommitmentRangeForHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2749,12 +4544,31 @@ func (m *QueryLatestUnbondingHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestUnbondingHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataCommitmentRangeForHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestUnbondingHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataCommitmentRangeForHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2776,7 +4590,7 @@ func (m *QueryLatestUnbondingHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestUnbondingHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDataCommitmentRangeForHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2799,17 +4613,17 @@ func (m *QueryLatestUnbondingHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestUnbondingHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataCommitmentRangeForHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestUnbondingHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataCommitmentRangeForHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataCommitment", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2819,11 +4633,28 @@ func (m *QueryLatestUnbondingHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataCommitment == nil {
+				m.DataCommitment = &DataCommitment{}
+			}
+			if err := m.DataCommitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2845,7 +4676,7 @@ func (m *QueryLatestUnbondingHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestDataCommitmentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEVMAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2868,12 +4699,44 @@ func (m *QueryLatestDataCommitmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestDataCommitmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEVMAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestDataCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEVMAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2895,7 +4758,7 @@ func (m *QueryLatestDataCommitmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestDataCommitmentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEVMAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2918,17 +4781,17 @@ func (m *QueryLatestDataCommitmentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestDataCommitmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEVMAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestDataCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEVMAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataCommitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2938,27 +4801,23 @@ func (m *QueryLatestDataCommitmentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DataCommitment == nil {
-				m.DataCommitment = &DataCommitment{}
-			}
-			if err := m.DataCommitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2981,7 +4840,7 @@ func (m *QueryLatestDataCommitmentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDataCommitmentRootRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3004,17 +4863,17 @@ func (m *QueryDataCommitmentRangeForHeightRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataCommitmentRangeForHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataCommitmentRootRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataCommitmentRangeForHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataCommitmentRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.Height = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3024,7 +4883,7 @@ func (m *QueryDataCommitmentRangeForHeightRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3050,7 +4909,7 @@ func (m *QueryDataCommitmentRangeForHeightRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDataCommitmentRootResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3073,10 +4932,10 @@ func (m *QueryDataCommitmentRangeForHeightResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataCommitmentRangeForHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataCommitmentRootResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataCommitmentRangeForHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataCommitmentRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3115,6 +4974,40 @@ func (m *QueryDataCommitmentRangeForHeightResponse) Unmarshal(dAtA []byte) error
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRootTupleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRootTupleRoot = append(m.DataRootTupleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRootTupleRoot == nil {
+				m.DataRootTupleRoot = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3136,7 +5029,7 @@ func (m *QueryDataCommitmentRangeForHeightResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryEVMAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDataRootTupleInclusionProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3159,17 +5052,17 @@ func (m *QueryEVMAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEVMAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataRootTupleInclusionProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEVMAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataRootTupleInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3179,24 +5072,11 @@ func (m *QueryEVMAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3218,7 +5098,7 @@ func (m *QueryEVMAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEVMAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDataRootTupleInclusionProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3241,17 +5121,17 @@ func (m *QueryEVMAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEVMAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataRootTupleInclusionProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEVMAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataRootTupleInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataCommitment", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3261,23 +5141,128 @@ func (m *QueryEVMAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			if m.DataCommitment == nil {
+				m.DataCommitment = &DataCommitment{}
+			}
+			if err := m.DataCommitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRootTupleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRootTupleRoot = append(m.DataRootTupleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRootTupleRoot == nil {
+				m.DataRootTupleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36,6 +36,10 @@ type MsgRegisterEVMAddress struct {
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	// The matching HEX encoded EVM address.
 	EvmAddress string `protobuf:"bytes,2,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+	// Optional EIP-191 personal_sign signature, by the EVM key, of the
+	// ownership message committing to the validator address and the chain ID.
+	// If set, the EVM address is only registered if the signature is valid.
+	EvmSignature []byte `protobuf:"bytes,3,opt,name=evm_signature,json=evmSignature,proto3" json:"evm_signature,omitempty"`
 }
 
 func (m *MsgRegisterEVMAddress) Reset()         { *m = MsgRegisterEVMAddress{} }
@@ -85,6 +89,13 @@ func (m *MsgRegisterEVMAddress) GetEvmAddress() string {
 	return ""
 }
 
+func (m *MsgRegisterEVMAddress) GetEvmSignature() []byte {
+	if m != nil {
+		return m.EvmSignature
+	}
+	return nil
+}
+
 // MsgRegisterEVMAddressResponse is the response to registering an EVM address.
 type MsgRegisterEVMAddressResponse struct {
 }
@@ -130,28 +141,30 @@ func init() {
 func init() { proto.RegisterFile("celestia/qgb/v1/tx.proto", fileDescriptor_85ed1095628e2204) }
 
 var fileDescriptor_85ed1095628e2204 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4a, 0x03, 0x31,
-	0x10, 0x86, 0x9b, 0x0a, 0x82, 0xf1, 0xa0, 0x2e, 0x15, 0x6a, 0xa9, 0xa9, 0x14, 0x11, 0x2f, 0x4d,
-	0xa8, 0x82, 0x77, 0x0b, 0x3d, 0x16, 0x64, 0x05, 0x0f, 0x5e, 0x4a, 0xb6, 0x0d, 0x31, 0xb0, 0xbb,
-	0xb3, 0xcd, 0xc4, 0xa5, 0x9e, 0x04, 0x9f, 0x40, 0xf4, 0xe6, 0x73, 0xf8, 0x10, 0x1e, 0x8b, 0x5e,
-	0x3c, 0x4a, 0xeb, 0x83, 0x48, 0xbb, 0xbb, 0x45, 0xa4, 0x07, 0x6f, 0x93, 0xfc, 0x5f, 0xfe, 0x7f,
-	0x32, 0x43, 0xab, 0x03, 0x15, 0x2a, 0x74, 0x46, 0x8a, 0x91, 0x0e, 0x44, 0xda, 0x16, 0x6e, 0xcc,
-	0x13, 0x0b, 0x0e, 0xbc, 0xad, 0x42, 0xe1, 0x23, 0x1d, 0xf0, 0xb4, 0x5d, 0xab, 0x68, 0xd0, 0xb0,
-	0xd0, 0xc4, 0xbc, 0xca, 0xb0, 0xda, 0xde, 0x00, 0x30, 0x02, 0xec, 0x67, 0x42, 0x76, 0xc8, 0xa5,
-	0xba, 0x06, 0xd0, 0xa1, 0x12, 0x32, 0x31, 0x42, 0xc6, 0x31, 0x38, 0xe9, 0x0c, 0xc4, 0xb9, 0xda,
-	0xbc, 0xa7, 0xbb, 0x3d, 0xd4, 0xbe, 0xd2, 0x06, 0x9d, 0xb2, 0xdd, 0xab, 0xde, 0xf9, 0x70, 0x68,
-	0x15, 0xa2, 0xd7, 0xa5, 0x3b, 0xa9, 0x0c, 0xcd, 0x50, 0x3a, 0xb0, 0x7d, 0x99, 0x5d, 0x56, 0xc9,
-	0x01, 0x39, 0xde, 0xe8, 0x54, 0xdf, 0x5f, 0x5b, 0x95, 0x3c, 0x23, 0xc7, 0x2f, 0x9d, 0x35, 0xb1,
-	0xf6, 0xb7, 0x97, 0x4f, 0x0a, 0x9b, 0x06, 0xdd, 0x54, 0x69, 0xb4, 0x34, 0x28, 0xcf, 0x0d, 0x7c,
-	0xaa, 0xd2, 0x28, 0x07, 0x9a, 0x0d, 0xba, 0xbf, 0xb2, 0x01, 0x5f, 0x61, 0x02, 0x31, 0xaa, 0x93,
-	0x17, 0x42, 0xd7, 0x7a, 0xa8, 0xbd, 0x27, 0x42, 0xbd, 0x15, 0x7d, 0x1e, 0xf1, 0x3f, 0x13, 0xe2,
-	0x2b, 0xed, 0x6a, 0xfc, 0x7f, 0x5c, 0x11, 0xdb, 0x3c, 0x7c, 0xf8, 0xf8, 0x7e, 0x2e, 0x33, 0xaf,
-	0x5e, 0xac, 0xc4, 0xe6, 0x6c, 0xff, 0xd7, 0x7f, 0x3a, 0x17, 0x6f, 0x53, 0x46, 0x26, 0x53, 0x46,
-	0xbe, 0xa6, 0x8c, 0x3c, 0xce, 0x58, 0x69, 0x32, 0x63, 0xa5, 0xcf, 0x19, 0x2b, 0x5d, 0x9f, 0x69,
-	0xe3, 0x6e, 0x6e, 0x03, 0x3e, 0x80, 0x48, 0x14, 0xc9, 0x60, 0xf5, 0xb2, 0x6e, 0xc9, 0x24, 0x11,
-	0x63, 0x11, 0x84, 0x10, 0xa0, 0xb3, 0x4a, 0x46, 0xc2, 0xdd, 0x25, 0x0a, 0x83, 0xf5, 0xc5, 0x5e,
-	0x4e, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x18, 0x1a, 0xed, 0x13, 0x02, 0x00, 0x00,
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x86, 0x3b, 0x2d, 0x5c, 0xb8, 0x73, 0x7b, 0xb9, 0xd7, 0x50, 0x21, 0x96, 0x9a, 0x96, 0x2a,
+	0xd2, 0x4d, 0x33, 0x54, 0xc1, 0xbd, 0x85, 0x2e, 0x0b, 0x92, 0x82, 0x0b, 0x37, 0x65, 0xd2, 0x1e,
+	0xc6, 0x81, 0x24, 0x27, 0x9d, 0x99, 0x86, 0xba, 0xf5, 0x09, 0x44, 0x77, 0xae, 0x7d, 0x04, 0x1f,
+	0xc2, 0x65, 0xd1, 0x8d, 0x4b, 0x69, 0x7d, 0x10, 0x69, 0x93, 0x14, 0x91, 0x2e, 0xdc, 0x9d, 0x39,
+	0xff, 0x37, 0xff, 0x3f, 0x67, 0x0e, 0xb5, 0x47, 0x10, 0x80, 0x36, 0x92, 0xb3, 0x89, 0xf0, 0x59,
+	0xd2, 0x61, 0x66, 0xe6, 0xc6, 0x0a, 0x0d, 0x5a, 0xff, 0x72, 0xc5, 0x9d, 0x08, 0xdf, 0x4d, 0x3a,
+	0xd5, 0x8a, 0x40, 0x81, 0x6b, 0x8d, 0xad, 0xaa, 0x14, 0xab, 0xee, 0x8d, 0x50, 0x87, 0xa8, 0x87,
+	0xa9, 0x90, 0x1e, 0x32, 0xa9, 0x26, 0x10, 0x45, 0x00, 0x8c, 0xc7, 0x92, 0xf1, 0x28, 0x42, 0xc3,
+	0x8d, 0xc4, 0x28, 0x53, 0x9b, 0x8f, 0x84, 0xee, 0xf6, 0xb5, 0xf0, 0x40, 0x48, 0x6d, 0x40, 0xf5,
+	0x2e, 0xfa, 0x67, 0xe3, 0xb1, 0x02, 0xad, 0xad, 0x1e, 0xdd, 0x49, 0x78, 0x20, 0xc7, 0xdc, 0xa0,
+	0x1a, 0xf2, 0xb4, 0x69, 0x93, 0x06, 0x69, 0xfd, 0xee, 0xda, 0x2f, 0x4f, 0xed, 0x4a, 0x16, 0x92,
+	0xe1, 0x03, 0xa3, 0x64, 0x24, 0xbc, 0xff, 0x9b, 0x2b, 0xb9, 0x4d, 0x9d, 0xfe, 0x81, 0x24, 0xdc,
+	0x18, 0x14, 0x57, 0x06, 0x1e, 0x85, 0x24, 0xcc, 0x81, 0x03, 0xfa, 0x77, 0x05, 0x68, 0x29, 0x22,
+	0x6e, 0xa6, 0x0a, 0xec, 0x52, 0x83, 0xb4, 0xca, 0x5e, 0x19, 0x92, 0x70, 0x90, 0xf7, 0x9a, 0x75,
+	0xba, 0xbf, 0xf5, 0x95, 0x1e, 0xe8, 0x18, 0x23, 0x0d, 0xc7, 0x0f, 0x84, 0x96, 0xfa, 0x5a, 0x58,
+	0x77, 0x84, 0x5a, 0x5b, 0x86, 0x39, 0x72, 0xbf, 0xfd, 0xa3, 0xbb, 0xd5, 0xae, 0xea, 0xfe, 0x8c,
+	0xcb, 0x63, 0x9b, 0x87, 0x37, 0xaf, 0x1f, 0xf7, 0x45, 0xc7, 0xaa, 0xe5, 0x8b, 0x53, 0x19, 0x3b,
+	0xfc, 0x32, 0x74, 0xf7, 0xfc, 0x79, 0xe1, 0x90, 0xf9, 0xc2, 0x21, 0xef, 0x0b, 0x87, 0xdc, 0x2e,
+	0x9d, 0xc2, 0x7c, 0xe9, 0x14, 0xde, 0x96, 0x4e, 0xe1, 0xf2, 0x54, 0x48, 0x73, 0x35, 0xf5, 0xdd,
+	0x11, 0x86, 0x2c, 0x4f, 0x46, 0x25, 0x36, 0x75, 0x9b, 0xc7, 0x31, 0x9b, 0x31, 0x3f, 0x40, 0x5f,
+	0x1b, 0x05, 0x3c, 0x64, 0xe6, 0x3a, 0x06, 0xed, 0xff, 0x5a, 0x6f, 0xef, 0xe4, 0x73, 0x00, 0xf0,
+	0x08, 0x9a, 0xd1, 0x39, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -170,7 +183,8 @@ type MsgClient interface {
 	// by the relayer to aggregate signatures. A validator can only register a
 	// single EVM address. The EVM address can be overridden by a later message.
 	// There are no validity checks of the EVM addresses existence on the Ethereum
-	// state machine.
+	// state machine. Control of the EVM key can optionally be proven by
+	// providing an evm_signature.
 	RegisterEVMAddress(ctx context.Context, in *MsgRegisterEVMAddress, opts ...grpc.CallOption) (*MsgRegisterEVMAddressResponse, error)
 }
 
@@ -197,7 +211,8 @@ type MsgServer interface {
 	// by the relayer to aggregate signatures. A validator can only register a
 	// single EVM address. The EVM address can be overridden by a later message.
 	// There are no validity checks of the EVM addresses existence on the Ethereum
-	// state machine.
+	// state machine. Control of the EVM key can optionally be proven by
+	// providing an evm_signature.
 	RegisterEVMAddress(context.Context, *MsgRegisterEVMAddress) (*MsgRegisterEVMAddressResponse, error)
 }
 
@@ -264,6 +279,13 @@ func (m *MsgRegisterEVMAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmSignature) > 0 {
+		i -= len(m.EvmSignature)
+		copy(dAtA[i:], m.EvmSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvmSignature)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.EvmAddress) > 0 {
 		i -= len(m.EvmAddress)
 		copy(dAtA[i:], m.EvmAddress)
@@ -329,6 +351,10 @@ func (m *MsgRegisterEVMAddress) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.EvmSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -440,6 +466,40 @@ func (m *MsgRegisterEVMAddress) Unmarshal(dAtA []byte) error {
 			}
 			m.EvmAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmSignature = append(m.EvmSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmSignature == nil {
+				m.EvmSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
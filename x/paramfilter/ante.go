@@ -0,0 +1,74 @@
+package paramfilter
+
+import (
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+// ParamFilterDecorator rejects governance proposals that attempt to modify a
+// blocked parameter at submission time so that they never reach the voting
+// period. Proposals are checked again when they are executed because the block
+// list may be extended while a proposal is being voted on. It only applies from
+// app version 3 so that the blocks of earlier versions are executed as they
+// were originally.
+type ParamFilterDecorator struct {
+	keeper Keeper
+}
+
+func NewParamFilterDecorator(keeper Keeper) ParamFilterDecorator {
+	return ParamFilterDecorator{keeper: keeper}
+}
+
+// AnteHandle implements the AnteHandler interface. It checks legacy
+// ParameterChangeProposals submitted via v1beta1 and v1 governance, including
+// the ones wrapped in a MsgExecLegacyContent, as well as messages that
+// implement ParamsUpdateMsg included in v1 proposals.
+func (d ParamFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeader().Version.App < v3.Version {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, m := range tx.GetMsgs() {
+		if err := d.validateMsg(ctx, m); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (d ParamFilterDecorator) validateMsg(ctx sdk.Context, msg sdk.Msg) error {
+	switch m := msg.(type) {
+	case *govv1beta1.MsgSubmitProposal:
+		return d.validateContent(ctx, m.GetContent())
+	case *govv1.MsgSubmitProposal:
+		msgs, err := m.GetMsgs()
+		if err != nil {
+			return err
+		}
+		for _, proposalMsg := range msgs {
+			if err := d.validateMsg(ctx, proposalMsg); err != nil {
+				return err
+			}
+		}
+	case *govv1.MsgExecLegacyContent:
+		content, err := govv1.LegacyContentFromMessage(m)
+		if err != nil {
+			return err
+		}
+		return d.validateContent(ctx, content)
+	case ParamsUpdateMsg:
+		return d.keeper.ValidateParamsUpdate(ctx, m)
+	}
+	return nil
+}
+
+func (d ParamFilterDecorator) validateContent(ctx sdk.Context, content govv1beta1.Content) error {
+	if c, ok := content.(*proposal.ParameterChangeProposal); ok {
+		return d.keeper.ValidateParamChanges(ctx, c.Changes)
+	}
+	return nil
+}
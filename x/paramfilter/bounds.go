@@ -0,0 +1,125 @@
+package paramfilter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParamBound restricts the values that governance proposals can set for a
+// numeric parameter. A bound can limit the parameter to a range and it can
+// limit how much the parameter may change in a single proposal. Restrictions
+// that are left unset are not enforced.
+type ParamBound struct {
+	Subspace string
+	Key      string
+
+	// Min is the smallest value that the parameter can be set to.
+	Min *sdk.Dec
+	// Max is the largest value that the parameter can be set to.
+	Max *sdk.Dec
+	// MaxChangeFactor is the factor by which the parameter can be increased or
+	// decreased by a single proposal. For example, a factor of 2 allows the
+	// parameter to at most double or halve.
+	MaxChangeFactor *sdk.Dec
+}
+
+// NewParamBound returns a ParamBound for the given subspace and key that does
+// not enforce any restrictions.
+func NewParamBound(subspace, key string) ParamBound {
+	return ParamBound{Subspace: subspace, Key: key}
+}
+
+// WithRange returns a copy of the bound that only allows values within
+// [min, max].
+func (b ParamBound) WithRange(min, max sdk.Dec) ParamBound {
+	b.Min = &min
+	b.Max = &max
+	return b
+}
+
+// WithMaxChangeFactor returns a copy of the bound that only allows values
+// within [current / factor, current * factor].
+func (b ParamBound) WithMaxChangeFactor(factor sdk.Dec) ParamBound {
+	b.MaxChangeFactor = &factor
+	return b
+}
+
+// ValidateBasic returns an error if the bound is malformed.
+func (b ParamBound) ValidateBasic() error {
+	if b.Subspace == "" || b.Key == "" {
+		return fmt.Errorf("param bound must have a subspace and a key: %v", b)
+	}
+	if b.Min != nil && b.Max != nil && b.Min.GT(*b.Max) {
+		return fmt.Errorf("param bound %s: min %s is greater than max %s", b.id(), b.Min, b.Max)
+	}
+	if b.MaxChangeFactor != nil && b.MaxChangeFactor.LT(sdk.OneDec()) {
+		return fmt.Errorf("param bound %s: max change factor %s must be at least 1", b.id(), b.MaxChangeFactor)
+	}
+	return nil
+}
+
+// Validate returns an error if changing the parameter from current to proposed
+// violates the bound. Both values are the amino JSON encoded parameter values
+// as they appear in the param store and in a ParamChange. An empty current
+// value means that the parameter has not been set yet, in which case the max
+// change factor is not enforced.
+func (b ParamBound) Validate(current []byte, proposed string) error {
+	value, err := parseDec(proposed)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrParamOutOfBounds, "%s/%s: %s", b.Subspace, b.Key, err)
+	}
+
+	if b.Min != nil && value.LT(*b.Min) {
+		return sdkerrors.Wrapf(ErrParamOutOfBounds, "%s/%s: %s is less than the minimum %s", b.Subspace, b.Key, value, b.Min)
+	}
+	if b.Max != nil && value.GT(*b.Max) {
+		return sdkerrors.Wrapf(ErrParamOutOfBounds, "%s/%s: %s is greater than the maximum %s", b.Subspace, b.Key, value, b.Max)
+	}
+
+	if b.MaxChangeFactor == nil || len(current) == 0 {
+		return nil
+	}
+	currentValue, err := parseDec(string(current))
+	if err != nil {
+		return sdkerrors.Wrapf(ErrParamOutOfBounds, "%s/%s: current value: %s", b.Subspace, b.Key, err)
+	}
+	// a parameter that is currently zero can not be scaled
+	if currentValue.IsZero() {
+		return nil
+	}
+	if value.GT(currentValue.Mul(*b.MaxChangeFactor)) {
+		return sdkerrors.Wrapf(ErrParamOutOfBounds, "%s/%s: %s is more than %s times the current value %s", b.Subspace, b.Key, value, b.MaxChangeFactor, currentValue)
+	}
+	if value.Mul(*b.MaxChangeFactor).LT(currentValue) {
+		return sdkerrors.Wrapf(ErrParamOutOfBounds, "%s/%s: %s is less than 1/%s of the current value %s", b.Subspace, b.Key, value, b.MaxChangeFactor, currentValue)
+	}
+	return nil
+}
+
+// id returns the identifier used to index the bound.
+func (b ParamBound) id() string {
+	return NewBlockedParam(b.Subspace, b.Key).id()
+}
+
+// parseDec parses an amino JSON encoded numeric parameter value. Integers are
+// encoded as either JSON numbers or strings depending on their size and
+// sdk.Dec values are encoded as strings.
+func parseDec(value string) (sdk.Dec, error) {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, `"`) {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return sdk.Dec{}, fmt.Errorf("invalid value %s: %w", value, err)
+		}
+		value = unquoted
+	}
+	dec, err := sdk.NewDecFromStr(value)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("value %s is not numeric: %w", value, err)
+	}
+	return dec, nil
+}
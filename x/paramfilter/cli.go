@@ -0,0 +1,96 @@
+package paramfilter
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	paramsutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the CLI query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        ModuleName,
+		Short:                      "Querying commands for the paramfilter module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryBlockedParams())
+	return cmd
+}
+
+func CmdQueryBlockedParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "blocked-params",
+		Short:   "Query for the parameters that can not be modified by governance",
+		Args:    cobra.NoArgs,
+		Example: "blocked-params",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := NewQueryClient(clientCtx)
+			resp, err := queryClient.BlockedParams(cmd.Context(), &QueryBlockedParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdSimulateParamChange returns a command that dry-runs a parameter change
+// proposal. It is registered under the gov tx command next to
+// submit-legacy-proposal param-change and accepts the same proposal file.
+func CmdSimulateParamChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-param-change [proposal-file]",
+		Short: "Dry-run a parameter change proposal without submitting it",
+		Long: `Dry-run a parameter change proposal against the parameter block list, the
+parameter bounds and the module validators of the current state. The proposal
+file has the same format as for submit-legacy-proposal param-change. Nothing is
+submitted and no deposit is required.`,
+		Args:    cobra.ExactArgs(1),
+		Example: "simulate-param-change proposal.json",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := paramsutils.ParseParamChangeProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := NewQueryClient(clientCtx)
+			resp, err := queryClient.SimulateParamChange(cmd.Context(), &QuerySimulateParamChangeRequest{
+				Changes: proposal.Changes.ToParamChanges(),
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := clientCtx.PrintProto(resp); err != nil {
+				return err
+			}
+			if resp.Error != "" {
+				return fmt.Errorf("proposal would fail: %s", resp.Error)
+			}
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,30 @@
+package paramfilter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default genesis state. The hard-coded block list
+// always applies so the default state block list is empty.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		BlockedParams: []BlockedParam{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	return validateBlockedParams(gs.BlockedParams)
+}
+
+// InitGenesis initializes the paramfilter module's state from a provided
+// genesis state.
+func InitGenesis(ctx sdk.Context, k Keeper, genState GenesisState) {
+	k.SetParams(ctx, Params{BlockedParams: genState.BlockedParams})
+}
+
+// ExportGenesis returns the paramfilter module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) *GenesisState {
+	return &GenesisState{BlockedParams: k.GetParams(ctx).BlockedParams}
+}
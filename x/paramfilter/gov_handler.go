@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	sdkerrors "cosmossdk.io/errors"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	legacysdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -14,31 +16,40 @@ import (
 // ParamBlockList keeps track of parameters that cannot be changed by governance
 // proposals
 type ParamBlockList struct {
-	params map[string]bool
+	params map[string]BlockedParam
 }
 
 // NewParamBlockList creates a new ParamBlockList that can be used to block gov
 // proposals that attempt to change locked parameters.
 func NewParamBlockList(blockedParams ...[2]string) ParamBlockList {
-	consolidatedParams := make(map[string]bool, len(blockedParams))
+	consolidatedParams := make(map[string]BlockedParam, len(blockedParams))
 	for _, param := range blockedParams {
-		consolidatedParams[fmt.Sprintf("%s-%s", param[0], param[1])] = true
+		bp := NewBlockedParam(param[0], param[1])
+		consolidatedParams[bp.id()] = bp
 	}
 	return ParamBlockList{params: consolidatedParams}
 }
 
 // IsBlocked returns true if the given parameter is blocked.
 func (pbl ParamBlockList) IsBlocked(subspace string, key string) bool {
-	return pbl.params[fmt.Sprintf("%s-%s", subspace, key)]
+	_, ok := pbl.params[NewBlockedParam(subspace, key).id()]
+	return ok
 }
 
 // GovHandler creates a new governance Handler for a ParamChangeProposal using
-// the underlying ParamBlockList.
+// the underlying ParamBlockList and the parameters that have been added to the
+// block list in state.
 func (pbl ParamBlockList) GovHandler(pk paramskeeper.Keeper) govtypes.Handler {
+	return NewKeeper(pk, pbl).GovHandler()
+}
+
+// GovHandler creates a new governance Handler for a ParamChangeProposal that
+// rejects proposals changing any blocked parameter.
+func (k Keeper) GovHandler() govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *proposal.ParameterChangeProposal:
-			return pbl.handleParameterChangeProposal(ctx, pk, c)
+			return k.handleParameterChangeProposal(ctx, c)
 
 		default:
 			return sdkerrors.Wrapf(legacysdkerrors.ErrUnknownRequest, "unrecognized param proposal content type: %T", c)
@@ -46,25 +57,22 @@ func (pbl ParamBlockList) GovHandler(pk paramskeeper.Keeper) govtypes.Handler {
 	}
 }
 
-func (pbl ParamBlockList) handleParameterChangeProposal(
+func (k Keeper) handleParameterChangeProposal(
 	ctx sdk.Context,
-	pk paramskeeper.Keeper,
 	p *proposal.ParameterChangeProposal,
 ) error {
 	// throw an error if any of the parameter changes are blocked
-	for _, c := range p.Changes {
-		if pbl.IsBlocked(c.Subspace, c.Key) {
-			return ErrBlockedParameter
-		}
+	if err := k.ValidateParamChanges(ctx, p.Changes); err != nil {
+		return err
 	}
 
 	for _, c := range p.Changes {
-		ss, ok := pk.GetSubspace(c.Subspace)
+		ss, ok := k.paramsKeeper.GetSubspace(c.Subspace)
 		if !ok {
 			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
 		}
 
-		pk.Logger(ctx).Info(
+		k.paramsKeeper.Logger(ctx).Info(
 			fmt.Sprintf("attempt to set new parameter value; key: %s, value: %s", c.Key, c.Value),
 		)
 
@@ -75,3 +83,65 @@ func (pbl ParamBlockList) handleParameterChangeProposal(
 
 	return nil
 }
+
+// ValidateParamChanges returns an error if any of the changes modifies a
+// blocked parameter, sets a parameter outside of its bounds or removes a
+// parameter from the block list. Bounds that limit the change per proposal are
+// checked against the values before the proposal is applied. Bounds and the
+// block list in state only apply from app version 3.
+func (k Keeper) ValidateParamChanges(ctx sdk.Context, changes []proposal.ParamChange) error {
+	for _, c := range changes {
+		if k.IsBlocked(ctx, c.Subspace, c.Key) {
+			return sdkerrors.Wrapf(ErrBlockedParameter, "%s/%s", c.Subspace, c.Key)
+		}
+		if ctx.BlockHeader().Version.App < v3.Version {
+			continue
+		}
+		if err := k.validateParamBound(ctx, c); err != nil {
+			return err
+		}
+		if c.Subspace == ModuleName && c.Key == string(KeyBlockedParams) {
+			if err := k.validateBlockListExtension(ctx, c.Value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateParamBound returns an error if the change violates the bound of the
+// parameter. Parameters without a bound are not restricted.
+func (k Keeper) validateParamBound(ctx sdk.Context, c proposal.ParamChange) error {
+	bound, ok := k.bounds[NewBlockedParam(c.Subspace, c.Key).id()]
+	if !ok {
+		return nil
+	}
+
+	var current []byte
+	if ss, ok := k.paramsKeeper.GetSubspace(c.Subspace); ok {
+		current = ss.GetRaw(ctx, []byte(c.Key))
+	}
+	return bound.Validate(current, c.Value)
+}
+
+// validateBlockListExtension returns an error if the proposed value of the
+// BlockedParams param does not contain every parameter that is currently in the
+// block list.
+func (k Keeper) validateBlockListExtension(ctx sdk.Context, value string) error {
+	var proposed []BlockedParam
+	if err := codec.NewLegacyAmino().UnmarshalJSON([]byte(value), &proposed); err != nil {
+		return sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", KeyBlockedParams, value, err.Error())
+	}
+
+	proposedSet := make(map[string]bool, len(proposed))
+	for _, bp := range proposed {
+		proposedSet[bp.id()] = true
+	}
+
+	for _, bp := range k.GetParams(ctx).BlockedParams {
+		if !proposedSet[bp.id()] {
+			return sdkerrors.Wrapf(ErrBlockListShrink, "%s/%s is missing from the proposed block list", bp.Subspace, bp.Key)
+		}
+	}
+	return nil
+}
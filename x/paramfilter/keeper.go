@@ -0,0 +1,145 @@
+package paramfilter
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	sdkerrors "cosmossdk.io/errors"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ QueryServer = Keeper{}
+
+// ParamsUpdateMsg is implemented by messages that update module parameters
+// directly instead of via a legacy ParameterChangeProposal, such as the
+// MsgUpdateParams messages executed by v1 governance proposals. The block list
+// is applied to every parameter returned by UpdatedParams.
+type ParamsUpdateMsg interface {
+	sdk.Msg
+	// UpdatedParams returns the subspace and key of every parameter that the
+	// message modifies.
+	UpdatedParams() [][2]string
+}
+
+// Keeper manages the parameter block list. A parameter is blocked if it is
+// either part of the hard-coded ParamBlockList, which can only be changed via
+// a hard fork, or if it has been added to the block list in state via
+// governance. Parameters can be added to the block list in state but never
+// removed. Parameters that are not blocked can additionally be restricted by a
+// ParamBound.
+type Keeper struct {
+	paramsKeeper paramskeeper.Keeper
+	paramStore   paramtypes.Subspace
+	blockList    ParamBlockList
+	bounds       map[string]ParamBound
+}
+
+// NewKeeper returns a paramfilter keeper. The paramfilter subspace must have
+// been registered in the params keeper. It panics if any of the bounds is
+// invalid or if a parameter has more than one bound.
+func NewKeeper(paramsKeeper paramskeeper.Keeper, blockList ParamBlockList, bounds ...ParamBound) Keeper {
+	subspace, exists := paramsKeeper.GetSubspace(ModuleName)
+	if !exists {
+		panic("paramfilter subspace not set")
+	}
+	if !subspace.HasKeyTable() {
+		subspace = subspace.WithKeyTable(ParamKeyTable())
+	}
+
+	boundsByID := make(map[string]ParamBound, len(bounds))
+	for _, b := range bounds {
+		if err := b.ValidateBasic(); err != nil {
+			panic(err)
+		}
+		if _, exists := boundsByID[b.id()]; exists {
+			panic(fmt.Sprintf("duplicate param bound: %s/%s", b.Subspace, b.Key))
+		}
+		boundsByID[b.id()] = b
+	}
+
+	return Keeper{
+		paramsKeeper: paramsKeeper,
+		paramStore:   subspace,
+		blockList:    blockList,
+		bounds:       boundsByID,
+	}
+}
+
+// GetParams returns the parameters that have been added to the block list in
+// state.
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	params := Params{BlockedParams: []BlockedParam{}}
+	k.paramStore.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the parameters that have been added to the block list in
+// state.
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramStore.SetParamSet(ctx, &params)
+}
+
+// IsBlocked returns true if the given parameter is either hard-coded in the
+// block list or has been added to the block list in state. The block list in
+// state only applies from app version 3.
+func (k Keeper) IsBlocked(ctx sdk.Context, subspace, key string) bool {
+	if k.blockList.IsBlocked(subspace, key) {
+		return true
+	}
+	if ctx.BlockHeader().Version.App < v3.Version {
+		return false
+	}
+	id := NewBlockedParam(subspace, key).id()
+	for _, bp := range k.GetParams(ctx).BlockedParams {
+		if bp.id() == id {
+			return true
+		}
+	}
+	return false
+}
+
+// GetBlockedParams returns the union of the hard-coded and the state block
+// lists sorted by subspace and key.
+func (k Keeper) GetBlockedParams(ctx sdk.Context) []BlockedParam {
+	blocked := make(map[string]BlockedParam)
+	for id, bp := range k.blockList.params {
+		blocked[id] = bp
+	}
+	for _, bp := range k.GetParams(ctx).BlockedParams {
+		blocked[bp.id()] = bp
+	}
+
+	result := make([]BlockedParam, 0, len(blocked))
+	for _, bp := range blocked {
+		result = append(result, bp)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Subspace != result[j].Subspace {
+			return result[i].Subspace < result[j].Subspace
+		}
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
+
+// ValidateParamsUpdate returns an error if the message modifies a blocked
+// parameter. Message servers that implement a ParamsUpdateMsg should call this
+// before applying the update because the block list may have been extended
+// after the proposal was submitted.
+func (k Keeper) ValidateParamsUpdate(ctx sdk.Context, msg ParamsUpdateMsg) error {
+	for _, param := range msg.UpdatedParams() {
+		if k.IsBlocked(ctx, param[0], param[1]) {
+			return sdkerrors.Wrapf(ErrBlockedParameter, "%s/%s", param[0], param[1])
+		}
+	}
+	return nil
+}
+
+// BlockedParams implements the Query/BlockedParams gRPC method.
+func (k Keeper) BlockedParams(ctx context.Context, _ *QueryBlockedParamsRequest) (*QueryBlockedParamsResponse, error) {
+	return &QueryBlockedParamsResponse{BlockedParams: k.GetBlockedParams(sdk.UnwrapSDKContext(ctx))}, nil
+}
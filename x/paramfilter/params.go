@@ -0,0 +1,63 @@
+package paramfilter
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// KeyBlockedParams is the key of the parameter that holds the parameters added
+// to the block list via governance.
+var KeyBlockedParams = []byte("BlockedParams")
+
+// Params are the parameters of the paramfilter module. They are stored in the
+// paramfilter subspace so that governance can extend the block list via a
+// regular ParameterChangeProposal.
+type Params struct {
+	BlockedParams []BlockedParam
+}
+
+// ParamKeyTable returns the param key table for the paramfilter module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs gets the param key-value pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyBlockedParams, &p.BlockedParams, validateBlockedParams),
+	}
+}
+
+// NewBlockedParam returns a BlockedParam for the given subspace and key.
+func NewBlockedParam(subspace, key string) BlockedParam {
+	return BlockedParam{Subspace: subspace, Key: key}
+}
+
+// id returns the identifier used to index the blocked param.
+func (bp BlockedParam) id() string {
+	return fmt.Sprintf("%s-%s", bp.Subspace, bp.Key)
+}
+
+// validateBlockedParams validates the BlockedParams param.
+func validateBlockedParams(i interface{}) error {
+	blockedParams, ok := i.([]BlockedParam)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(blockedParams))
+	for _, bp := range blockedParams {
+		if bp.Subspace == "" || bp.Key == "" {
+			return fmt.Errorf("blocked param must have a subspace and a key: %v", bp)
+		}
+		if seen[bp.id()] {
+			return fmt.Errorf("duplicate blocked param: %s/%s", bp.Subspace, bp.Key)
+		}
+		seen[bp.id()] = true
+	}
+
+	return nil
+}
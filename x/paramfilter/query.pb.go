@@ -0,0 +1,1252 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/paramfilter/v1/query.proto
+
+package paramfilter
+
+import (
+	context "context"
+	fmt "fmt"
+	proposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryBlockedParamsRequest is the request type for the BlockedParams query.
+type QueryBlockedParamsRequest struct {
+}
+
+func (m *QueryBlockedParamsRequest) Reset()         { *m = QueryBlockedParamsRequest{} }
+func (m *QueryBlockedParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedParamsRequest) ProtoMessage()    {}
+func (*QueryBlockedParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e89f8360e6682, []int{0}
+}
+func (m *QueryBlockedParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedParamsRequest.Merge(m, src)
+}
+func (m *QueryBlockedParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedParamsRequest proto.InternalMessageInfo
+
+// QueryBlockedParamsResponse is the response type for the BlockedParams query.
+type QueryBlockedParamsResponse struct {
+	// BlockedParams is the union of the hard-coded and governance-added blocked
+	// parameters, sorted by subspace and key.
+	BlockedParams []BlockedParam `protobuf:"bytes,1,rep,name=blocked_params,json=blockedParams,proto3" json:"blocked_params"`
+}
+
+func (m *QueryBlockedParamsResponse) Reset()         { *m = QueryBlockedParamsResponse{} }
+func (m *QueryBlockedParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedParamsResponse) ProtoMessage()    {}
+func (*QueryBlockedParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e89f8360e6682, []int{1}
+}
+func (m *QueryBlockedParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedParamsResponse.Merge(m, src)
+}
+func (m *QueryBlockedParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedParamsResponse proto.InternalMessageInfo
+
+func (m *QueryBlockedParamsResponse) GetBlockedParams() []BlockedParam {
+	if m != nil {
+		return m.BlockedParams
+	}
+	return nil
+}
+
+// QuerySimulateParamChangeRequest is the request type for the
+// SimulateParamChange query.
+type QuerySimulateParamChangeRequest struct {
+	// Changes are the parameter changes of a ParameterChangeProposal.
+	Changes []proposal.ParamChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *QuerySimulateParamChangeRequest) Reset()         { *m = QuerySimulateParamChangeRequest{} }
+func (m *QuerySimulateParamChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateParamChangeRequest) ProtoMessage()    {}
+func (*QuerySimulateParamChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e89f8360e6682, []int{2}
+}
+func (m *QuerySimulateParamChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateParamChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateParamChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateParamChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateParamChangeRequest.Merge(m, src)
+}
+func (m *QuerySimulateParamChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateParamChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateParamChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateParamChangeRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateParamChangeRequest) GetChanges() []proposal.ParamChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// ParamChangeResult is the outcome of simulating a single parameter change.
+type ParamChangeResult struct {
+	Change proposal.ParamChange `protobuf:"bytes,1,opt,name=change,proto3" json:"change"`
+	// Error is the reason the change would fail. It is empty if the change
+	// would succeed.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ParamChangeResult) Reset()         { *m = ParamChangeResult{} }
+func (m *ParamChangeResult) String() string { return proto.CompactTextString(m) }
+func (*ParamChangeResult) ProtoMessage()    {}
+func (*ParamChangeResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e89f8360e6682, []int{3}
+}
+func (m *ParamChangeResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChangeResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChangeResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamChangeResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChangeResult.Merge(m, src)
+}
+func (m *ParamChangeResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChangeResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChangeResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChangeResult proto.InternalMessageInfo
+
+func (m *ParamChangeResult) GetChange() proposal.ParamChange {
+	if m != nil {
+		return m.Change
+	}
+	return proposal.ParamChange{}
+}
+
+func (m *ParamChangeResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// QuerySimulateParamChangeResponse is the response type for the
+// SimulateParamChange query.
+type QuerySimulateParamChangeResponse struct {
+	// Results contains the outcome of applying each change on its own, in the
+	// order of the request.
+	Results []ParamChangeResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	// Error is the reason the proposal as a whole would fail. It is empty if the
+	// proposal would succeed.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *QuerySimulateParamChangeResponse) Reset()         { *m = QuerySimulateParamChangeResponse{} }
+func (m *QuerySimulateParamChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateParamChangeResponse) ProtoMessage()    {}
+func (*QuerySimulateParamChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e89f8360e6682, []int{4}
+}
+func (m *QuerySimulateParamChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateParamChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateParamChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateParamChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateParamChangeResponse.Merge(m, src)
+}
+func (m *QuerySimulateParamChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateParamChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateParamChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateParamChangeResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateParamChangeResponse) GetResults() []ParamChangeResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *QuerySimulateParamChangeResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryBlockedParamsRequest)(nil), "celestia.paramfilter.v1.QueryBlockedParamsRequest")
+	proto.RegisterType((*QueryBlockedParamsResponse)(nil), "celestia.paramfilter.v1.QueryBlockedParamsResponse")
+	proto.RegisterType((*QuerySimulateParamChangeRequest)(nil), "celestia.paramfilter.v1.QuerySimulateParamChangeRequest")
+	proto.RegisterType((*ParamChangeResult)(nil), "celestia.paramfilter.v1.ParamChangeResult")
+	proto.RegisterType((*QuerySimulateParamChangeResponse)(nil), "celestia.paramfilter.v1.QuerySimulateParamChangeResponse")
+}
+
+func init() {
+	proto.RegisterFile("celestia/paramfilter/v1/query.proto", fileDescriptor_0e7e89f8360e6682)
+}
+
+var fileDescriptor_0e7e89f8360e6682 = []byte{
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xbf, 0x6e, 0xd4, 0x30,
+	0x18, 0x8f, 0x0f, 0xda, 0x0a, 0xa3, 0x22, 0x61, 0x2a, 0x71, 0x04, 0x94, 0x46, 0x46, 0x45, 0xe5,
+	0x24, 0x6c, 0x72, 0x5d, 0x80, 0x09, 0x85, 0x0d, 0x16, 0x08, 0x1b, 0x4b, 0xe5, 0x04, 0x93, 0x46,
+	0x4d, 0xe2, 0xd4, 0x76, 0x4e, 0xb0, 0x22, 0x1e, 0x00, 0x89, 0x17, 0xe0, 0x41, 0x78, 0x80, 0x8e,
+	0x95, 0x58, 0x98, 0x10, 0xba, 0x63, 0xe1, 0x2d, 0x50, 0x6d, 0x07, 0xe5, 0xca, 0x05, 0xa9, 0x6c,
+	0x3e, 0x7f, 0xbf, 0xbf, 0xbe, 0x2f, 0xf0, 0x76, 0xc6, 0x4b, 0xae, 0x74, 0xc1, 0x68, 0xc3, 0x24,
+	0xab, 0xde, 0x14, 0xa5, 0xe6, 0x92, 0xce, 0x22, 0x7a, 0xd4, 0x72, 0xf9, 0x8e, 0x34, 0x52, 0x68,
+	0x81, 0xae, 0x77, 0x20, 0xd2, 0x03, 0x91, 0x59, 0xe4, 0x6f, 0xe5, 0x22, 0x17, 0x06, 0x43, 0x4f,
+	0x4f, 0x16, 0xee, 0xdf, 0xca, 0x85, 0xc8, 0x4b, 0x4e, 0x59, 0x53, 0x50, 0x56, 0xd7, 0x42, 0x33,
+	0x5d, 0x88, 0x5a, 0xb9, 0xe9, 0xdd, 0x21, 0xc7, 0xbe, 0xb6, 0x85, 0xe2, 0x4c, 0xa8, 0x4a, 0x28,
+	0x3b, 0x51, 0x74, 0x16, 0xa5, 0x5c, 0x33, 0x07, 0x74, 0x72, 0xf8, 0x26, 0xbc, 0xf1, 0xe2, 0x34,
+	0x6a, 0x5c, 0x8a, 0xec, 0x90, 0xbf, 0x7e, 0x6e, 0x66, 0x09, 0x3f, 0x6a, 0xb9, 0xd2, 0xb8, 0x81,
+	0xfe, 0xaa, 0xa1, 0x6a, 0x44, 0xad, 0x38, 0x4a, 0xe0, 0x95, 0xd4, 0x0e, 0xf6, 0xad, 0xe4, 0x18,
+	0x84, 0x17, 0x76, 0x2f, 0x4f, 0x77, 0xc8, 0x40, 0x5f, 0xd2, 0xd7, 0x89, 0x2f, 0x1e, 0x7f, 0xdf,
+	0xf6, 0x92, 0xcd, 0xb4, 0xaf, 0x8d, 0x39, 0xdc, 0x36, 0x8e, 0x2f, 0x8b, 0xaa, 0x2d, 0x99, 0xe6,
+	0xe6, 0xfa, 0xc9, 0x01, 0xab, 0x73, 0xee, 0x42, 0xa1, 0x18, 0x6e, 0x64, 0xe6, 0xa2, 0xf3, 0xc3,
+	0xc4, 0xf6, 0x24, 0xae, 0x98, 0xeb, 0x49, 0x7a, 0x5c, 0x67, 0xd6, 0x11, 0xf1, 0x21, 0xbc, 0xba,
+	0xa4, 0xac, 0xda, 0x52, 0xa3, 0xc7, 0x70, 0xdd, 0xce, 0xc7, 0x20, 0x04, 0xe7, 0xd2, 0x75, 0x3c,
+	0xb4, 0x05, 0xd7, 0xb8, 0x94, 0x42, 0x8e, 0x47, 0x21, 0xd8, 0xbd, 0x94, 0xd8, 0x1f, 0xf8, 0x03,
+	0x80, 0xe1, 0x70, 0x29, 0xf7, 0x98, 0x4f, 0xe1, 0x86, 0x34, 0x31, 0xba, 0x56, 0x93, 0xc1, 0x57,
+	0xfc, 0x2b, 0x79, 0xd7, 0xce, 0x09, 0xac, 0x8e, 0x31, 0xfd, 0x35, 0x82, 0x6b, 0x26, 0x06, 0xfa,
+	0x0c, 0xe0, 0xe6, 0xd2, 0x5f, 0x8a, 0xa6, 0x83, 0x66, 0x83, 0xcb, 0xe1, 0xef, 0x9d, 0x8b, 0x63,
+	0x6b, 0xe2, 0x3b, 0xef, 0xbf, 0xfe, 0xfc, 0x34, 0x0a, 0x51, 0x70, 0x76, 0x7b, 0x97, 0x37, 0x09,
+	0x7d, 0x01, 0xf0, 0xda, 0x8a, 0xe7, 0x42, 0x0f, 0xfe, 0x6d, 0x3a, 0xbc, 0x36, 0xfe, 0xc3, 0xff,
+	0x60, 0xba, 0xd0, 0xf7, 0x4d, 0xe8, 0xc9, 0x23, 0x30, 0xc1, 0x3b, 0x67, 0x73, 0x2b, 0xc7, 0xb3,
+	0xc1, 0xf7, 0xed, 0x22, 0xc4, 0xcf, 0x8e, 0xe7, 0x01, 0x38, 0x99, 0x07, 0xe0, 0xc7, 0x3c, 0x00,
+	0x1f, 0x17, 0x81, 0x77, 0xb2, 0x08, 0xbc, 0x6f, 0x8b, 0xc0, 0x7b, 0x15, 0xe5, 0x85, 0x3e, 0x68,
+	0x53, 0x92, 0x89, 0x8a, 0x76, 0x81, 0x84, 0xcc, 0xff, 0x9c, 0xef, 0xb1, 0xa6, 0xa1, 0x6f, 0xfb,
+	0x2e, 0xe9, 0xba, 0xf9, 0x52, 0xf7, 0x7e, 0x0f, 0x00, 0x95, 0x08, 0x67, 0xb9, 0x6c, 0x04, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// BlockedParams returns all parameters that can not be modified by
+	// governance.
+	BlockedParams(ctx context.Context, in *QueryBlockedParamsRequest, opts ...grpc.CallOption) (*QueryBlockedParamsResponse, error)
+	// SimulateParamChange dry-runs a set of parameter changes against the block
+	// list, the parameter bounds and the module validators without modifying
+	// state.
+	SimulateParamChange(ctx context.Context, in *QuerySimulateParamChangeRequest, opts ...grpc.CallOption) (*QuerySimulateParamChangeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) BlockedParams(ctx context.Context, in *QueryBlockedParamsRequest, opts ...grpc.CallOption) (*QueryBlockedParamsResponse, error) {
+	out := new(QueryBlockedParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.paramfilter.v1.Query/BlockedParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateParamChange(ctx context.Context, in *QuerySimulateParamChangeRequest, opts ...grpc.CallOption) (*QuerySimulateParamChangeResponse, error) {
+	out := new(QuerySimulateParamChangeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.paramfilter.v1.Query/SimulateParamChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// BlockedParams returns all parameters that can not be modified by
+	// governance.
+	BlockedParams(context.Context, *QueryBlockedParamsRequest) (*QueryBlockedParamsResponse, error)
+	// SimulateParamChange dry-runs a set of parameter changes against the block
+	// list, the parameter bounds and the module validators without modifying
+	// state.
+	SimulateParamChange(context.Context, *QuerySimulateParamChangeRequest) (*QuerySimulateParamChangeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) BlockedParams(ctx context.Context, req *QueryBlockedParamsRequest) (*QueryBlockedParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedParams not implemented")
+}
+func (*UnimplementedQueryServer) SimulateParamChange(ctx context.Context, req *QuerySimulateParamChangeRequest) (*QuerySimulateParamChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateParamChange not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_BlockedParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.paramfilter.v1.Query/BlockedParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedParams(ctx, req.(*QueryBlockedParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateParamChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateParamChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateParamChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.paramfilter.v1.Query/SimulateParamChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateParamChange(ctx, req.(*QuerySimulateParamChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.paramfilter.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlockedParams",
+			Handler:    _Query_BlockedParams_Handler,
+		},
+		{
+			MethodName: "SimulateParamChange",
+			Handler:    _Query_SimulateParamChange_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/paramfilter/v1/query.proto",
+}
+
+func (m *QueryBlockedParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockedParams) > 0 {
+		for iNdEx := len(m.BlockedParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateParamChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateParamChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateParamChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamChangeResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChangeResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChangeResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Change.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateParamChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateParamChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateParamChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBlockedParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockedParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockedParams) > 0 {
+		for _, e := range m.BlockedParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateParamChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamChangeResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Change.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateParamChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBlockedParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedParams = append(m.BlockedParams, BlockedParam{})
+			if err := m.BlockedParams[len(m.BlockedParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateParamChangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateParamChangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateParamChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, proposal.ParamChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamChangeResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamChangeResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamChangeResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateParamChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateParamChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateParamChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, ParamChangeResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,60 @@
+package paramfilter
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SimulateParamChange implements the Query/SimulateParamChange gRPC method. It
+// runs the governance handler on a branch of the current state for every
+// change individually and then for all changes together. The branches are
+// discarded so state is never modified.
+func (k Keeper) SimulateParamChange(goCtx context.Context, req *QuerySimulateParamChangeRequest) (*QuerySimulateParamChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Changes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no parameter changes")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := &QuerySimulateParamChangeResponse{
+		Results: make([]ParamChangeResult, len(req.Changes)),
+	}
+	for i, c := range req.Changes {
+		resp.Results[i] = ParamChangeResult{Change: c}
+		if err := k.SimulateParamChanges(ctx, []proposal.ParamChange{c}); err != nil {
+			resp.Results[i].Error = err.Error()
+		}
+	}
+	if err := k.SimulateParamChanges(ctx, req.Changes); err != nil {
+		resp.Error = err.Error()
+	}
+
+	return resp, nil
+}
+
+// SimulateParamChanges returns the error that executing a
+// ParameterChangeProposal with the given changes would return. The changes are
+// applied to a branch of ctx that is discarded afterwards.
+func (k Keeper) SimulateParamChanges(ctx sdk.Context, changes []proposal.ParamChange) (err error) {
+	if err := proposal.ValidateChanges(changes); err != nil {
+		return err
+	}
+
+	// the params keeper panics when a key is not registered in the subspace's
+	// key table
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	cacheCtx, _ := ctx.CacheContext()
+	return k.handleParameterChangeProposal(cacheCtx, proposal.NewParameterChangeProposal("", "", changes))
+}
@@ -0,0 +1,238 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+const maxValidatorsBlockList = `[{"subspace":"staking","key":"MaxValidators"}]`
+
+func setupBlockList(t *testing.T) (*app.App, sdk.Context) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	header := types.Header{Version: version.Consensus{App: v3.Version}}
+	ctx := sdk.NewContext(testApp.CommitMultiStore(), header, false, tmlog.NewNopLogger())
+	return testApp, ctx
+}
+
+func TestExtendBlockList(t *testing.T) {
+	testApp, ctx := setupBlockList(t)
+	handler := testApp.ParamFilterKeeper.GovHandler()
+	maxValidators := string(stakingtypes.KeyMaxValidators)
+
+	require.False(t, testApp.ParamFilterKeeper.IsBlocked(ctx, stakingtypes.ModuleName, maxValidators))
+
+	extend := proposal.NewParamChange(paramfilter.ModuleName, string(paramfilter.KeyBlockedParams), maxValidatorsBlockList)
+	require.NoError(t, handler(ctx, testProposal(extend)))
+
+	require.True(t, testApp.ParamFilterKeeper.IsBlocked(ctx, stakingtypes.ModuleName, maxValidators))
+	require.Equal(t, []paramfilter.BlockedParam{
+		paramfilter.NewBlockedParam(stakingtypes.ModuleName, maxValidators),
+	}, testApp.ParamFilterKeeper.GetParams(ctx).BlockedParams)
+
+	// the newly blocked parameter can no longer be changed
+	change := proposal.NewParamChange(stakingtypes.ModuleName, maxValidators, "1")
+	err := handler(ctx, testProposal(change))
+	require.ErrorIs(t, err, paramfilter.ErrBlockedParameter)
+	require.NotEqual(t, uint32(1), testApp.StakingKeeper.GetParams(ctx).MaxValidators)
+
+	// parameters can not be removed from the block list
+	shrink := proposal.NewParamChange(paramfilter.ModuleName, string(paramfilter.KeyBlockedParams), `[]`)
+	err = handler(ctx, testProposal(shrink))
+	require.ErrorIs(t, err, paramfilter.ErrBlockListShrink)
+	require.Len(t, testApp.ParamFilterKeeper.GetParams(ctx).BlockedParams, 1)
+
+	// extending the block list further is allowed
+	historicalEntries := string(stakingtypes.KeyHistoricalEntries)
+	extend = proposal.NewParamChange(
+		paramfilter.ModuleName,
+		string(paramfilter.KeyBlockedParams),
+		`[{"subspace":"staking","key":"MaxValidators"},{"subspace":"staking","key":"HistoricalEntries"}]`,
+	)
+	require.NoError(t, handler(ctx, testProposal(extend)))
+	require.True(t, testApp.ParamFilterKeeper.IsBlocked(ctx, stakingtypes.ModuleName, historicalEntries))
+}
+
+func TestBlockListBeforeV3(t *testing.T) {
+	testApp, ctx := setupBlockList(t)
+	ctx = ctx.WithBlockHeader(types.Header{Version: version.Consensus{App: v2.Version}})
+	handler := testApp.ParamFilterKeeper.GovHandler()
+	maxValidators := string(stakingtypes.KeyMaxValidators)
+
+	testApp.ParamFilterKeeper.SetParams(ctx, paramfilter.Params{
+		BlockedParams: []paramfilter.BlockedParam{paramfilter.NewBlockedParam(stakingtypes.ModuleName, maxValidators)},
+	})
+
+	// the block list in state is not applied before app version 3
+	require.False(t, testApp.ParamFilterKeeper.IsBlocked(ctx, stakingtypes.ModuleName, maxValidators))
+	change := proposal.NewParamChange(stakingtypes.ModuleName, maxValidators, "1")
+	require.NoError(t, handler(ctx, testProposal(change)))
+	require.Equal(t, uint32(1), testApp.StakingKeeper.GetParams(ctx).MaxValidators)
+
+	// the hard-coded block list still applies
+	blocked := testApp.BlockedParams()[0]
+	require.True(t, testApp.ParamFilterKeeper.IsBlocked(ctx, blocked[0], blocked[1]))
+}
+
+func TestInvalidBlockList(t *testing.T) {
+	testApp, ctx := setupBlockList(t)
+	handler := testApp.ParamFilterKeeper.GovHandler()
+
+	testCases := []struct {
+		name  string
+		value string
+	}{
+		{"not a list", `"staking"`},
+		{"missing key", `[{"subspace":"staking"}]`},
+		{"duplicate", `[{"subspace":"staking","key":"MaxValidators"},{"subspace":"staking","key":"MaxValidators"}]`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			change := proposal.NewParamChange(paramfilter.ModuleName, string(paramfilter.KeyBlockedParams), tc.value)
+			require.Error(t, handler(ctx, testProposal(change)))
+			require.Empty(t, testApp.ParamFilterKeeper.GetParams(ctx).BlockedParams)
+		})
+	}
+}
+
+func TestQueryBlockedParams(t *testing.T) {
+	testApp, ctx := setupBlockList(t)
+	testApp.ParamFilterKeeper.SetParams(ctx, paramfilter.Params{
+		BlockedParams: []paramfilter.BlockedParam{
+			paramfilter.NewBlockedParam(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators)),
+		},
+	})
+
+	resp, err := testApp.ParamFilterKeeper.BlockedParams(sdk.WrapSDKContext(ctx), &paramfilter.QueryBlockedParamsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.BlockedParams, len(testApp.BlockedParams())+1)
+
+	for _, p := range append(testApp.BlockedParams(), [2]string{stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators)}) {
+		require.Contains(t, resp.BlockedParams, paramfilter.NewBlockedParam(p[0], p[1]))
+	}
+}
+
+func TestParamFilterDecorator(t *testing.T) {
+	testApp, ctx := setupBlockList(t)
+	decorator := paramfilter.NewParamFilterDecorator(testApp.ParamFilterKeeper)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	blocked := testApp.BlockedParams()[0]
+	blockedChange := proposal.NewParamChange(blocked[0], blocked[1], "value")
+	validChange := proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "1")
+
+	v1beta1Msg := func(change proposal.ParamChange) sdk.Msg {
+		msg, err := govv1beta1.NewMsgSubmitProposal(testProposal(change), sdk.NewCoins(), proposer())
+		require.NoError(t, err)
+		return msg
+	}
+	v1Msg := func(change proposal.ParamChange) sdk.Msg {
+		exec, err := govv1.NewLegacyContent(testProposal(change), proposer().String())
+		require.NoError(t, err)
+		msg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{exec}, sdk.NewCoins(), proposer().String(), "")
+		require.NoError(t, err)
+		return msg
+	}
+
+	testCases := []struct {
+		name       string
+		appVersion uint64
+		msg        sdk.Msg
+		wantErr    bool
+	}{
+		{"v1beta1 blocked", v3.Version, v1beta1Msg(blockedChange), true},
+		{"v1beta1 valid", v3.Version, v1beta1Msg(validChange), false},
+		{"v1 blocked", v3.Version, v1Msg(blockedChange), true},
+		{"v1 valid", v3.Version, v1Msg(validChange), false},
+		{"params update blocked", v3.Version, paramsUpdateMsg(blocked), true},
+		{"params update valid", v3.Version, paramsUpdateMsg([2]string{stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators)}), false},
+		// proposals are only rejected at execution before app version 3
+		{"v1beta1 blocked in app version 2", v2.Version, v1beta1Msg(blockedChange), false},
+		{"v1 blocked in app version 2", v2.Version, v1Msg(blockedChange), false},
+		{"params update blocked in app version 2", v2.Version, paramsUpdateMsg(blocked), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := ctx.WithBlockHeader(types.Header{Version: version.Consensus{App: tc.appVersion}})
+			_, err := decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{tc.msg}}, false, next)
+			if tc.wantErr {
+				require.ErrorIs(t, err, paramfilter.ErrBlockedParameter)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGenesis(t *testing.T) {
+	testApp, ctx := setupBlockList(t)
+
+	genState := paramfilter.GenesisState{
+		BlockedParams: []paramfilter.BlockedParam{
+			paramfilter.NewBlockedParam(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators)),
+		},
+	}
+	require.NoError(t, genState.Validate())
+
+	paramfilter.InitGenesis(ctx, testApp.ParamFilterKeeper, genState)
+	require.Equal(t, &genState, paramfilter.ExportGenesis(ctx, testApp.ParamFilterKeeper))
+
+	invalid := paramfilter.GenesisState{BlockedParams: append(genState.BlockedParams, genState.BlockedParams...)}
+	require.Error(t, invalid.Validate())
+}
+
+func TestValidateParamsUpdate(t *testing.T) {
+	testApp, ctx := setupBlockList(t)
+	blocked := testApp.BlockedParams()[0]
+	valid := [2]string{stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators)}
+
+	err := testApp.ParamFilterKeeper.ValidateParamsUpdate(ctx, paramsUpdateMsg(valid, blocked))
+	require.ErrorIs(t, err, paramfilter.ErrBlockedParameter)
+	require.NoError(t, testApp.ParamFilterKeeper.ValidateParamsUpdate(ctx, paramsUpdateMsg(valid)))
+}
+
+func proposer() sdk.AccAddress {
+	return sdk.AccAddress("paramfilter_proposer")
+}
+
+// mockParamsUpdateMsg stands in for a MsgUpdateParams message. Only
+// UpdatedParams is implemented.
+type mockParamsUpdateMsg struct {
+	sdk.Msg
+	params [][2]string
+}
+
+func paramsUpdateMsg(params ...[2]string) paramfilter.ParamsUpdateMsg {
+	return mockParamsUpdateMsg{params: params}
+}
+
+func (m mockParamsUpdateMsg) UpdatedParams() [][2]string {
+	return m.params
+}
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg {
+	return tx.msgs
+}
+
+func (tx mockTx) ValidateBasic() error {
+	return nil
+}
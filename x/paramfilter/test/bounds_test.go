@@ -0,0 +1,114 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestParamBoundValidate(t *testing.T) {
+	rangeBound := paramfilter.NewParamBound("module", "Key").
+		WithRange(sdk.NewDecWithPrec(1, 6), sdk.OneDec())
+	factorBound := paramfilter.NewParamBound("module", "Key").
+		WithMaxChangeFactor(sdk.NewDec(2))
+
+	testCases := []struct {
+		name     string
+		bound    paramfilter.ParamBound
+		current  string
+		proposed string
+		wantErr  bool
+	}{
+		{"within range", rangeBound, "", `"0.5"`, false},
+		{"equal to min", rangeBound, "", `"0.000001"`, false},
+		{"equal to max", rangeBound, "", `"1"`, false},
+		{"below min", rangeBound, "", `"0.0000001"`, true},
+		{"above max", rangeBound, "", `"1.5"`, true},
+		{"not numeric", rangeBound, "", `"abc"`, true},
+		{"double", factorBound, `"64"`, `"128"`, false},
+		{"halve", factorBound, `"64"`, `"32"`, false},
+		{"unquoted value", factorBound, `64`, `100`, false},
+		{"more than double", factorBound, `"64"`, `"256"`, true},
+		{"less than half", factorBound, `"64"`, `"16"`, true},
+		{"current not set", factorBound, "", `"256"`, false},
+		{"current is zero", factorBound, `"0"`, `"256"`, false},
+		{"no restrictions", paramfilter.NewParamBound("module", "Key"), `"1"`, `"1000"`, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.bound.Validate([]byte(tc.current), tc.proposed)
+			if tc.wantErr {
+				require.ErrorIs(t, err, paramfilter.ErrParamOutOfBounds)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParamBoundValidateBasic(t *testing.T) {
+	require.NoError(t, paramfilter.NewParamBound("module", "Key").ValidateBasic())
+	require.Error(t, paramfilter.NewParamBound("", "Key").ValidateBasic())
+	require.Error(t, paramfilter.NewParamBound("module", "Key").WithRange(sdk.OneDec(), sdk.ZeroDec()).ValidateBasic())
+	require.Error(t, paramfilter.NewParamBound("module", "Key").WithMaxChangeFactor(sdk.NewDecWithPrec(5, 1)).ValidateBasic())
+}
+
+func TestParamBounds(t *testing.T) {
+	testApp, ctx := setupBlockList(t)
+	handler := testApp.ParamFilterKeeper.GovHandler()
+
+	initialSquareSize := testApp.BlobKeeper.GovMaxSquareSize(ctx)
+	squareSizeChange := func(size uint64) proposal.ParamChange {
+		return proposal.NewParamChange(blobtypes.ModuleName, string(blobtypes.KeyGovMaxSquareSize), fmt.Sprintf("\"%d\"", size))
+	}
+	minGasPriceChange := func(price string) proposal.ParamChange {
+		return proposal.NewParamChange(minfee.ModuleName, string(minfee.KeyNetworkMinGasPrice), `"`+price+`"`)
+	}
+
+	// the square size can not be quadrupled
+	err := handler(ctx, testProposal(squareSizeChange(initialSquareSize*4)))
+	require.ErrorIs(t, err, paramfilter.ErrParamOutOfBounds)
+	require.Equal(t, initialSquareSize, testApp.BlobKeeper.GovMaxSquareSize(ctx))
+
+	// the square size can be halved
+	require.NoError(t, handler(ctx, testProposal(squareSizeChange(initialSquareSize/2))))
+	require.Equal(t, initialSquareSize/2, testApp.BlobKeeper.GovMaxSquareSize(ctx))
+
+	// the network min gas price must stay within its range
+	err = handler(ctx, testProposal(minGasPriceChange("2")))
+	require.ErrorIs(t, err, paramfilter.ErrParamOutOfBounds)
+	err = handler(ctx, testProposal(minGasPriceChange("0")))
+	require.ErrorIs(t, err, paramfilter.ErrParamOutOfBounds)
+	require.NoError(t, handler(ctx, testProposal(minGasPriceChange("0.1"))))
+
+	// a proposal is rejected entirely if any change is out of bounds
+	err = handler(ctx, testProposal(minGasPriceChange("0.2"), squareSizeChange(1)))
+	require.ErrorIs(t, err, paramfilter.ErrParamOutOfBounds)
+
+	var minGasPrice sdk.Dec
+	testApp.GetSubspace(minfee.ModuleName).Get(ctx, minfee.KeyNetworkMinGasPrice, &minGasPrice)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), minGasPrice)
+}
+
+func TestParamBoundsBeforeV3(t *testing.T) {
+	testApp, ctx := setupBlockList(t)
+	ctx = ctx.WithBlockHeader(types.Header{Version: version.Consensus{App: v2.Version}})
+	handler := testApp.ParamFilterKeeper.GovHandler()
+
+	// the square size can be quadrupled because bounds don't apply before app
+	// version 3
+	squareSize := testApp.BlobKeeper.GovMaxSquareSize(ctx) * 4
+	change := proposal.NewParamChange(blobtypes.ModuleName, string(blobtypes.KeyGovMaxSquareSize), fmt.Sprintf("\"%d\"", squareSize))
+	require.NoError(t, handler(ctx, testProposal(change)))
+	require.Equal(t, squareSize, testApp.BlobKeeper.GovMaxSquareSize(ctx))
+}
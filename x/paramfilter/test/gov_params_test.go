@@ -163,6 +163,32 @@ func (suite *GovParamsTestSuite) TestModifiableParams() {
 				assert.Equal(want, got)
 			},
 		},
+		{
+			"blobstream.AttestationExpiryTime",
+			testProposal(proposal.ParamChange{
+				Subspace: bsmoduletypes.ModuleName,
+				Key:      string(bsmoduletypes.ParamsStoreKeyAttestationExpiryTime),
+				Value:    `"86400000000000"`,
+			}),
+			func() {
+				got := suite.app.BlobstreamKeeper.GetParams(suite.ctx).AttestationExpiryTime
+				want := 24 * time.Hour
+				assert.Equal(want, got)
+			},
+		},
+		{
+			"blobstream.SignificantPowerDifferenceThreshold",
+			testProposal(proposal.ParamChange{
+				Subspace: bsmoduletypes.ModuleName,
+				Key:      string(bsmoduletypes.ParamsStoreKeySignificantPowerDifferenceThreshold),
+				Value:    `"0.100000000000000000"`,
+			}),
+			func() {
+				got := suite.app.BlobstreamKeeper.GetParams(suite.ctx).SignificantPowerDifferenceThreshold
+				want := sdk.NewDecWithPrec(1, 1)
+				assert.Equal(want, got)
+			},
+		},
 		{
 			"consensus.block",
 			testProposal(proposal.ParamChange{
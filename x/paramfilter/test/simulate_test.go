@@ -0,0 +1,86 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateParamChange(t *testing.T) {
+	testApp, ctx := setupBlockList(t)
+	blocked := testApp.BlockedParams()[0]
+	maxValidators := testApp.StakingKeeper.GetParams(ctx).MaxValidators
+
+	validChange := proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "1")
+	blockedChange := proposal.NewParamChange(blocked[0], blocked[1], "value")
+	invalidValue := proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), `"abc"`)
+	unknownKey := proposal.NewParamChange(stakingtypes.ModuleName, "UnknownKey", "1")
+	unknownSubspace := proposal.NewParamChange("unknown", "Key", "1")
+
+	testCases := []struct {
+		name        string
+		changes     []proposal.ParamChange
+		wantErrs    []string
+		wantFailure bool
+	}{
+		{
+			name:     "valid change",
+			changes:  []proposal.ParamChange{validChange},
+			wantErrs: []string{""},
+		},
+		{
+			name:        "blocked change",
+			changes:     []proposal.ParamChange{validChange, blockedChange},
+			wantErrs:    []string{"", paramfilter.ErrBlockedParameter.Error()},
+			wantFailure: true,
+		},
+		{
+			name:        "invalid value",
+			changes:     []proposal.ParamChange{invalidValue},
+			wantErrs:    []string{proposal.ErrSettingParameter.Error()},
+			wantFailure: true,
+		},
+		{
+			name:        "unknown key",
+			changes:     []proposal.ParamChange{unknownKey},
+			wantErrs:    []string{"not registered"},
+			wantFailure: true,
+		},
+		{
+			name:        "unknown subspace",
+			changes:     []proposal.ParamChange{unknownSubspace},
+			wantErrs:    []string{proposal.ErrUnknownSubspace.Error()},
+			wantFailure: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := testApp.ParamFilterKeeper.SimulateParamChange(
+				sdk.WrapSDKContext(ctx),
+				&paramfilter.QuerySimulateParamChangeRequest{Changes: tc.changes},
+			)
+			require.NoError(t, err)
+			require.Len(t, resp.Results, len(tc.changes))
+			for i, result := range resp.Results {
+				require.Equal(t, tc.changes[i], result.Change)
+				if tc.wantErrs[i] == "" {
+					require.Empty(t, result.Error)
+					continue
+				}
+				require.Contains(t, result.Error, tc.wantErrs[i])
+			}
+			require.Equal(t, tc.wantFailure, resp.Error != "")
+
+			// simulations never modify state
+			require.Equal(t, maxValidators, testApp.StakingKeeper.GetParams(ctx).MaxValidators)
+		})
+	}
+
+	_, err := testApp.ParamFilterKeeper.SimulateParamChange(sdk.WrapSDKContext(ctx), &paramfilter.QuerySimulateParamChangeRequest{})
+	require.Error(t, err)
+}
@@ -10,6 +10,16 @@ const (
 	baseErrorCode = 91710
 )
 
-// ErrBlockedParameter is the error wrapped when a proposal to change a
-// blocked parameter is submitted.
-var ErrBlockedParameter = sdkerrors.Register(ModuleName, baseErrorCode, "parameter can not be modified")
+var (
+	// ErrBlockedParameter is the error wrapped when a proposal to change a
+	// blocked parameter is submitted.
+	ErrBlockedParameter = sdkerrors.Register(ModuleName, baseErrorCode, "parameter can not be modified")
+
+	// ErrBlockListShrink is the error wrapped when a proposal attempts to
+	// remove a parameter from the block list.
+	ErrBlockListShrink = sdkerrors.Register(ModuleName, baseErrorCode+1, "parameters can not be removed from the block list")
+
+	// ErrParamOutOfBounds is the error wrapped when a proposal attempts to set
+	// a parameter to a value outside of its bounds.
+	ErrParamOutOfBounds = sdkerrors.Register(ModuleName, baseErrorCode+2, "parameter value out of bounds")
+)
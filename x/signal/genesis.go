@@ -0,0 +1,19 @@
+package signal
+
+import (
+	"github.com/celestiaorg/celestia-app/v3/x/signal/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the signal module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+}
+
+// ExportGenesis returns the signal module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+	return genesis
+}
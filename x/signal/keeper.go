@@ -9,32 +9,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// DefaultUpgradeHeightDelay is the number of blocks after a quorum has been
-// reached that the chain should upgrade to the new version. Assuming a block
-// interval of 12 seconds, this is 7 days.
-const DefaultUpgradeHeightDelay = int64(7 * 24 * 60 * 60 / 12) // 7 days * 24 hours * 60 minutes * 60 seconds / 12 seconds per block = 50,400 blocks.
+// DefaultUpgradeHeightDelay is the default value of the UpgradeHeightDelay
+// param. The value in use by the chain can be modified via governance.
+const DefaultUpgradeHeightDelay = types.DefaultUpgradeHeightDelay
 
 // Keeper implements the MsgServer and QueryServer interfaces
 var (
 	_ types.MsgServer   = &Keeper{}
 	_ types.QueryServer = Keeper{}
-
-	// defaultSignalThreshold is 5/6 or approximately 83.33%
-	defaultSignalThreshold = sdk.NewDec(5).Quo(sdk.NewDec(6))
 )
 
-// Threshold is the fraction of voting power that is required
-// to signal for a version change. It is set to 5/6 as the middle point
-// between 2/3 and 3/3 providing 1/6 fault tolerance to halting the
-// network during an upgrade period. It can be modified through a
-// hard fork change that modified the app version
-func Threshold(_ uint64) sdk.Dec {
-	return defaultSignalThreshold
-}
-
 type Keeper struct {
 	// binaryCodec is used to marshal and unmarshal data from the store.
 	binaryCodec codec.BinaryCodec
@@ -43,6 +31,10 @@ type Keeper struct {
 	// store.
 	storeKey storetypes.StoreKey
 
+	// paramStore is the params subspace that stores the UpgradeHeightDelay
+	// and Threshold params.
+	paramStore paramtypes.Subspace
+
 	// stakingKeeper is used to fetch validators to calculate the total power
 	// signalled to a version.
 	stakingKeeper StakingKeeper
@@ -52,11 +44,17 @@ type Keeper struct {
 func NewKeeper(
 	binaryCodec codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
+	paramStore paramtypes.Subspace,
 	stakingKeeper StakingKeeper,
 ) Keeper {
+	if !paramStore.HasKeyTable() {
+		paramStore = paramStore.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		binaryCodec:   binaryCodec,
 		storeKey:      storeKey,
+		paramStore:    paramStore,
 		stakingKeeper: stakingKeeper,
 	}
 }
@@ -108,7 +106,7 @@ func (k *Keeper) TryUpgrade(ctx context.Context, _ *types.MsgTryUpgrade) (*types
 		}
 		upgrade := types.Upgrade{
 			AppVersion:    version,
-			UpgradeHeight: sdkCtx.BlockHeader().Height + DefaultUpgradeHeightDelay,
+			UpgradeHeight: sdkCtx.BlockHeader().Height + k.UpgradeHeightDelay(sdkCtx),
 		}
 		k.setUpgrade(sdkCtx, upgrade)
 	}
@@ -190,7 +188,7 @@ func (k Keeper) TallyVotingPower(ctx sdk.Context, threshold int64) (bool, uint64
 // upgrade to a new version.
 func (k Keeper) GetVotingPowerThreshold(ctx sdk.Context) sdkmath.Int {
 	totalVotingPower := k.stakingKeeper.GetLastTotalPower(ctx)
-	thresholdFraction := Threshold(ctx.BlockHeader().Version.App)
+	thresholdFraction := k.Threshold(ctx)
 	return thresholdFraction.MulInt(totalVotingPower).Ceil().TruncateInt()
 }
 
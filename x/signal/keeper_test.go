@@ -16,6 +16,7 @@ import (
 	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
 	"github.com/celestiaorg/celestia-app/v3/x/signal"
 	"github.com/celestiaorg/celestia-app/v3/x/signal/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -66,10 +67,9 @@ func TestGetVotingPowerThreshold(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
 			stakingKeeper := newMockStakingKeeper(tc.validators)
-			k := signal.NewKeeper(config.Codec, nil, stakingKeeper)
-			got := k.GetVotingPowerThreshold(sdk.Context{})
+			k, ctx := newKeeper(t, stakingKeeper)
+			got := k.GetVotingPowerThreshold(ctx)
 			assert.Equal(t, tc.want, got, fmt.Sprintf("want %v, got %v", tc.want.String(), got.String()))
 		})
 	}
@@ -430,10 +430,29 @@ func TestGetUpgrade(t *testing.T) {
 }
 
 func setup(t *testing.T) (signal.Keeper, sdk.Context, *mockStakingKeeper) {
+	mockStakingKeeper := newMockStakingKeeper(
+		map[string]int64{
+			testutil.ValAddrs[0].String(): 40,
+			testutil.ValAddrs[1].String(): 1,
+			testutil.ValAddrs[2].String(): 59,
+			testutil.ValAddrs[3].String(): 20,
+		},
+	)
+	upgradeKeeper, mockCtx := newKeeper(t, mockStakingKeeper)
+	return upgradeKeeper, mockCtx, mockStakingKeeper
+}
+
+// newKeeper returns a signal keeper backed by an in-memory store with the
+// default params set.
+func newKeeper(t *testing.T, stakingKeeper signal.StakingKeeper) (signal.Keeper, sdk.Context) {
 	signalStore := sdk.NewKVStoreKey(types.StoreKey)
+	paramsStore := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStore := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(signalStore, storetypes.StoreTypeIAVL, nil)
+	stateStore.MountStoreWithDB(paramsStore, storetypes.StoreTypeIAVL, nil)
+	stateStore.MountStoreWithDB(paramsTStore, storetypes.StoreTypeTransient, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 	mockCtx := sdk.NewContext(stateStore, tmproto.Header{
 		Version: tmversion.Consensus{
@@ -441,18 +460,12 @@ func setup(t *testing.T) (signal.Keeper, sdk.Context, *mockStakingKeeper) {
 			App:   1,
 		},
 	}, false, log.NewNopLogger())
-	mockStakingKeeper := newMockStakingKeeper(
-		map[string]int64{
-			testutil.ValAddrs[0].String(): 40,
-			testutil.ValAddrs[1].String(): 1,
-			testutil.ValAddrs[2].String(): 59,
-			testutil.ValAddrs[3].String(): 20,
-		},
-	)
 
 	config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
-	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, mockStakingKeeper)
-	return upgradeKeeper, mockCtx, mockStakingKeeper
+	subspace := paramstypes.NewSubspace(config.Codec, config.Amino, paramsStore, paramsTStore, types.ModuleName)
+	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, subspace, stakingKeeper)
+	upgradeKeeper.SetParams(mockCtx, types.DefaultParams())
+	return upgradeKeeper, mockCtx
 }
 
 var _ signal.StakingKeeper = (*mockStakingKeeper)(nil)
@@ -492,3 +505,77 @@ func (m *mockStakingKeeper) GetValidator(_ sdk.Context, addr sdk.ValAddress) (va
 	}
 	return stakingtypes.Validator{}, false
 }
+
+func TestTryUpgradeUsesParams(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	upgradeHeightDelay := int64(10)
+	upgradeKeeper.SetParams(ctx, types.NewParams(upgradeHeightDelay, sdk.NewDec(3).Quo(sdk.NewDec(4))))
+
+	res, err := upgradeKeeper.VersionTally(goCtx, &types.QueryVersionTallyRequest{Version: 2})
+	require.NoError(t, err)
+	require.EqualValues(t, 90, res.ThresholdPower) // 3/4 of 120
+
+	// 40 + 59 = 99 which is above the 3/4 threshold but below the default 5/6.
+	for _, valAddr := range []sdk.ValAddress{testutil.ValAddrs[0], testutil.ValAddrs[2]} {
+		_, err := upgradeKeeper.SignalVersion(goCtx, &types.MsgSignalVersion{
+			ValidatorAddress: valAddr.String(),
+			Version:          2,
+		})
+		require.NoError(t, err)
+	}
+
+	_, err = upgradeKeeper.TryUpgrade(goCtx, &types.MsgTryUpgrade{})
+	require.NoError(t, err)
+
+	got, err := upgradeKeeper.GetUpgrade(goCtx, &types.QueryGetUpgradeRequest{})
+	require.NoError(t, err)
+	require.NotNil(t, got.Upgrade)
+	assert.Equal(t, ctx.BlockHeight()+upgradeHeightDelay, got.Upgrade.UpgradeHeight)
+
+	shouldUpgrade, _ := upgradeKeeper.ShouldUpgrade(ctx.WithBlockHeight(ctx.BlockHeight() + upgradeHeightDelay))
+	require.True(t, shouldUpgrade)
+}
+
+func TestParams(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+
+	res, err := upgradeKeeper.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), res.Params)
+
+	params := types.NewParams(100, sdk.OneDec())
+	upgradeKeeper.SetParams(ctx, params)
+
+	res, err = upgradeKeeper.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+
+	exported := signal.ExportGenesis(ctx, upgradeKeeper)
+	require.Equal(t, params, exported.Params)
+
+	otherKeeper, otherCtx, _ := setup(t)
+	signal.InitGenesis(otherCtx, otherKeeper, *exported)
+	require.Equal(t, params, otherKeeper.GetParams(otherCtx))
+}
+
+func TestGetParamsFallsBackToDefaults(t *testing.T) {
+	signalStore := sdk.NewKVStoreKey(types.StoreKey)
+	paramsStore := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStore := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	stateStore := store.NewCommitMultiStore(tmdb.NewMemDB())
+	stateStore.MountStoreWithDB(signalStore, storetypes.StoreTypeIAVL, nil)
+	stateStore.MountStoreWithDB(paramsStore, storetypes.StoreTypeIAVL, nil)
+	stateStore.MountStoreWithDB(paramsTStore, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	subspace := paramstypes.NewSubspace(config.Codec, config.Amino, paramsStore, paramsTStore, types.ModuleName)
+	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, subspace, newMockStakingKeeper(nil))
+
+	// No params have been set so the keeper should return the defaults.
+	require.Equal(t, types.DefaultParams(), upgradeKeeper.GetParams(ctx))
+	require.Equal(t, signal.DefaultUpgradeHeightDelay, upgradeKeeper.UpgradeHeightDelay(ctx))
+}
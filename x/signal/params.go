@@ -0,0 +1,39 @@
+package signal
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v3/x/signal/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Params queries the parameters of the signal module.
+func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(sdk.UnwrapSDKContext(ctx))}, nil
+}
+
+// GetParams returns all parameters of the signal module. Params that have not
+// been persisted yet (e.g. on chains that started before the params were
+// introduced) fall back to their default values.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramStore.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the parameters of the signal module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramStore.SetParamSet(ctx, &params)
+}
+
+// UpgradeHeightDelay returns the number of blocks after a quorum has been
+// reached that the chain should upgrade to the new version.
+func (k Keeper) UpgradeHeightDelay(ctx sdk.Context) int64 {
+	return k.GetParams(ctx).UpgradeHeightDelay
+}
+
+// Threshold returns the fraction of voting power that is required to signal
+// for a version change.
+func (k Keeper) Threshold(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).Threshold
+}
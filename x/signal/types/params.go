@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// DefaultUpgradeHeightDelay is the number of blocks after a quorum has been
+// reached that the chain should upgrade to the new version. Assuming a block
+// interval of 12 seconds, this is 7 days.
+const DefaultUpgradeHeightDelay = int64(7 * 24 * 60 * 60 / 12) // 7 days * 24 hours * 60 minutes * 60 seconds / 12 seconds per block = 50,400 blocks.
+
+var (
+	KeyUpgradeHeightDelay = []byte("UpgradeHeightDelay")
+	KeyThreshold          = []byte("Threshold")
+
+	// DefaultThreshold is 5/6 or approximately 83.33%. It is the middle point
+	// between 2/3 and 3/3 providing 1/6 fault tolerance to halting the network
+	// during an upgrade period.
+	DefaultThreshold = sdk.NewDec(5).Quo(sdk.NewDec(6))
+
+	// MinThreshold is the lowest permitted threshold. Upgrading with less than
+	// 2/3 of the voting power signalling would risk halting the network.
+	MinThreshold = sdk.NewDec(2).Quo(sdk.NewDec(3))
+)
+
+// ParamKeyTable returns the param key table for the signal module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(upgradeHeightDelay int64, threshold sdk.Dec) Params {
+	return Params{
+		UpgradeHeightDelay: upgradeHeightDelay,
+		Threshold:          threshold,
+	}
+}
+
+// DefaultParams returns the default parameters which are used on mainnet.
+func DefaultParams() Params {
+	return NewParams(DefaultUpgradeHeightDelay, DefaultThreshold)
+}
+
+// ParamSetPairs gets the list of param key-value pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyUpgradeHeightDelay, &p.UpgradeHeightDelay, validateUpgradeHeightDelay),
+		paramtypes.NewParamSetPair(KeyThreshold, &p.Threshold, validateThreshold),
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateUpgradeHeightDelay(p.UpgradeHeightDelay); err != nil {
+		return err
+	}
+	return validateThreshold(p.Threshold)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// validateUpgradeHeightDelay validates the UpgradeHeightDelay param.
+func validateUpgradeHeightDelay(v interface{}) error {
+	upgradeHeightDelay, ok := v.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if upgradeHeightDelay <= 0 {
+		return fmt.Errorf("upgrade height delay must be positive: %d", upgradeHeightDelay)
+	}
+
+	return nil
+}
+
+// validateThreshold validates the Threshold param.
+func validateThreshold(v interface{}) error {
+	threshold, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if threshold.IsNil() {
+		return fmt.Errorf("threshold cannot be nil")
+	}
+
+	if threshold.LT(MinThreshold) {
+		return fmt.Errorf("threshold must be at least %s: %s", MinThreshold, threshold)
+	}
+
+	if threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("threshold cannot be greater than 1: %s", threshold)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultParams(t *testing.T) {
+	params := DefaultParams()
+	assert.NoError(t, params.Validate())
+	assert.Equal(t, int64(50_400), params.UpgradeHeightDelay)
+	assert.Equal(t, sdk.NewDec(5).Quo(sdk.NewDec(6)), params.Threshold)
+}
+
+func Test_validateUpgradeHeightDelay(t *testing.T) {
+	type test struct {
+		name      string
+		input     interface{}
+		expectErr bool
+	}
+	tests := []test{
+		{
+			name:      "valid",
+			input:     DefaultUpgradeHeightDelay,
+			expectErr: false,
+		},
+		{
+			name:      "one block",
+			input:     int64(1),
+			expectErr: false,
+		},
+		{
+			name:      "zero",
+			input:     int64(0),
+			expectErr: true,
+		},
+		{
+			name:      "negative",
+			input:     int64(-1),
+			expectErr: true,
+		},
+		{
+			name:      "wrong type",
+			input:     uint64(DefaultUpgradeHeightDelay),
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateUpgradeHeightDelay(tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_validateThreshold(t *testing.T) {
+	type test struct {
+		name      string
+		input     interface{}
+		expectErr bool
+	}
+	tests := []test{
+		{
+			name:      "valid",
+			input:     DefaultThreshold,
+			expectErr: false,
+		},
+		{
+			name:      "two thirds",
+			input:     sdk.NewDec(2).Quo(sdk.NewDec(3)),
+			expectErr: false,
+		},
+		{
+			name:      "one",
+			input:     sdk.OneDec(),
+			expectErr: false,
+		},
+		{
+			name:      "less than two thirds",
+			input:     sdk.NewDecWithPrec(66, 2),
+			expectErr: true,
+		},
+		{
+			name:      "greater than one",
+			input:     sdk.NewDecWithPrec(101, 2),
+			expectErr: true,
+		},
+		{
+			name:      "nil",
+			input:     sdk.Dec{},
+			expectErr: true,
+		},
+		{
+			name:      "wrong type",
+			input:     "0.9",
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateThreshold(tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -216,43 +217,132 @@ func (m *QueryGetUpgradeResponse) GetUpgrade() *Upgrade {
 	return nil
 }
 
+// QueryParamsRequest is the request type for the Params query.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{4}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Params query.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{5}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryVersionTallyRequest)(nil), "celestia.signal.v1.QueryVersionTallyRequest")
 	proto.RegisterType((*QueryVersionTallyResponse)(nil), "celestia.signal.v1.QueryVersionTallyResponse")
 	proto.RegisterType((*QueryGetUpgradeRequest)(nil), "celestia.signal.v1.QueryGetUpgradeRequest")
 	proto.RegisterType((*QueryGetUpgradeResponse)(nil), "celestia.signal.v1.QueryGetUpgradeResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.signal.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.signal.v1.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("celestia/signal/v1/query.proto", fileDescriptor_7af24246367e432c) }
 
 var fileDescriptor_7af24246367e432c = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x4f, 0xe2, 0x40,
-	0x18, 0xc6, 0x19, 0xf6, 0x0f, 0xc9, 0x40, 0x76, 0x37, 0x93, 0xcd, 0x6e, 0xb7, 0x4b, 0x1a, 0xb6,
-	0x97, 0xdd, 0xac, 0xd0, 0x09, 0xa8, 0x5f, 0xc0, 0x8b, 0x17, 0x0f, 0x48, 0x94, 0x83, 0x17, 0x32,
-	0xc0, 0xa4, 0x34, 0xa9, 0x9d, 0xa1, 0x33, 0xad, 0x12, 0xe3, 0x41, 0xbf, 0x80, 0x26, 0xc6, 0xa3,
-	0xdf, 0xc7, 0x23, 0x89, 0x17, 0x8f, 0x06, 0xfc, 0x20, 0x86, 0x99, 0x16, 0x30, 0x85, 0x84, 0x5b,
-	0xfb, 0xbe, 0xbf, 0xf7, 0x99, 0xe7, 0x7d, 0x66, 0xa0, 0xd5, 0xa3, 0x3e, 0x15, 0xd2, 0x23, 0x58,
-	0x78, 0x6e, 0x40, 0x7c, 0x1c, 0xd7, 0xf1, 0x30, 0xa2, 0xe1, 0xc8, 0xe1, 0x21, 0x93, 0x0c, 0xa1,
-	0xb4, 0xef, 0xe8, 0xbe, 0x13, 0xd7, 0xcd, 0xb2, 0xcb, 0x98, 0xeb, 0x53, 0x4c, 0xb8, 0x87, 0x49,
-	0x10, 0x30, 0x49, 0xa4, 0xc7, 0x02, 0xa1, 0x27, 0xcc, 0xca, 0x0a, 0xc5, 0x88, 0xbb, 0x21, 0xe9,
-	0x53, 0x4d, 0xd8, 0x3b, 0xd0, 0x38, 0x9c, 0x1d, 0xd1, 0xa6, 0xa1, 0xf0, 0x58, 0x70, 0x44, 0x7c,
-	0x7f, 0xd4, 0xa2, 0xc3, 0x88, 0x0a, 0x89, 0x0c, 0x58, 0x88, 0x75, 0xd9, 0x00, 0x15, 0xf0, 0xef,
-	0x63, 0x2b, 0xfd, 0xb5, 0xef, 0x01, 0xfc, 0xb5, 0x62, 0x4c, 0x70, 0x16, 0x08, 0x8a, 0xfe, 0xc0,
-	0x52, 0xcc, 0xa4, 0x17, 0xb8, 0x1d, 0xce, 0xce, 0x68, 0x98, 0x0c, 0x17, 0x75, 0xad, 0x39, 0x2b,
-	0xa1, 0xbf, 0xf0, 0xab, 0x1c, 0x84, 0x54, 0x0c, 0x98, 0xdf, 0x4f, 0xa8, 0xbc, 0xa2, 0xbe, 0xcc,
-	0xcb, 0x1a, 0xac, 0x42, 0x24, 0x99, 0x24, 0x7e, 0xe7, 0x9d, 0xe2, 0x07, 0xc5, 0x7e, 0x53, 0x9d,
-	0xf6, 0x42, 0xd6, 0x36, 0xe0, 0x0f, 0x65, 0x6b, 0x9f, 0xca, 0x63, 0xbd, 0x66, 0xb2, 0x8b, 0xdd,
-	0x84, 0x3f, 0x33, 0x9d, 0xc4, 0xee, 0x2e, 0x2c, 0x24, 0x99, 0x28, 0xa7, 0xc5, 0xc6, 0x6f, 0x27,
-	0x1b, 0xb4, 0x93, 0x4e, 0xa5, 0x6c, 0xe3, 0x21, 0x0f, 0x3f, 0x29, 0x49, 0x74, 0x03, 0x60, 0x69,
-	0x39, 0x08, 0x54, 0x5d, 0x25, 0xb0, 0x2e, 0x66, 0xb3, 0xb6, 0x21, 0xad, 0xed, 0xda, 0xf6, 0xf5,
-	0xd3, 0xeb, 0x5d, 0xbe, 0x8c, 0xcc, 0xa5, 0x3b, 0x95, 0x33, 0x02, 0x5f, 0x24, 0xd7, 0x73, 0x89,
-	0xae, 0x00, 0x84, 0x8b, 0x4d, 0xd1, 0xff, 0xb5, 0x27, 0x64, 0x82, 0x32, 0xb7, 0x36, 0x62, 0x13,
-	0x2f, 0xa6, 0xf2, 0xf2, 0x1d, 0xa1, 0xec, 0xfb, 0xda, 0x3b, 0x78, 0x9c, 0x58, 0x60, 0x3c, 0xb1,
-	0xc0, 0xcb, 0xc4, 0x02, 0xb7, 0x53, 0x2b, 0x37, 0x9e, 0x5a, 0xb9, 0xe7, 0xa9, 0x95, 0x3b, 0x69,
-	0xb8, 0x9e, 0x1c, 0x44, 0x5d, 0xa7, 0xc7, 0x4e, 0x71, 0x7a, 0x18, 0x0b, 0xdd, 0xf9, 0x77, 0x8d,
-	0x70, 0x8e, 0xcf, 0x53, 0x49, 0x39, 0xe2, 0x54, 0x74, 0x3f, 0xab, 0xe7, 0xba, 0xfd, 0x16, 0x00,
-	0x00, 0xff, 0xff, 0x5b, 0x0c, 0x46, 0x0b, 0x24, 0x03, 0x00, 0x00,
+	// 487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcd, 0x4e, 0x14, 0x41,
+	0x10, 0xc7, 0x77, 0x00, 0x97, 0xa4, 0x20, 0x7e, 0x14, 0x1b, 0x5d, 0x46, 0x32, 0xe0, 0x1c, 0xc4,
+	0x28, 0x4c, 0x87, 0x55, 0x13, 0xcf, 0x5c, 0xbc, 0x98, 0xb8, 0x6e, 0x94, 0x83, 0x17, 0xd2, 0x40,
+	0xa7, 0x77, 0x92, 0x61, 0xba, 0x99, 0xee, 0x5d, 0xdd, 0x18, 0x0f, 0xfa, 0x02, 0x9a, 0x10, 0xdf,
+	0x89, 0x23, 0x89, 0x17, 0x4f, 0xc6, 0xec, 0xfa, 0x20, 0x66, 0xfa, 0x03, 0xd8, 0xcc, 0x6c, 0xe4,
+	0x36, 0x53, 0xf5, 0xab, 0xfa, 0xff, 0xbb, 0xaa, 0x1b, 0xa2, 0x43, 0x96, 0x31, 0xa5, 0x53, 0x4a,
+	0x54, 0xca, 0x73, 0x9a, 0x91, 0xe1, 0x0e, 0x39, 0x19, 0xb0, 0x62, 0x94, 0xc8, 0x42, 0x68, 0x81,
+	0xe8, 0xf3, 0x89, 0xcd, 0x27, 0xc3, 0x9d, 0xb0, 0xc5, 0x05, 0x17, 0x26, 0x4d, 0xca, 0x2f, 0x4b,
+	0x86, 0x6b, 0x5c, 0x08, 0x9e, 0x31, 0x42, 0x65, 0x4a, 0x68, 0x9e, 0x0b, 0x4d, 0x75, 0x2a, 0x72,
+	0xe5, 0xb2, 0xeb, 0x35, 0x3a, 0x92, 0x16, 0xf4, 0xd8, 0x03, 0x1b, 0x35, 0xc0, 0x40, 0xf2, 0x82,
+	0x1e, 0x31, 0x4b, 0xc4, 0xcf, 0xa0, 0xfd, 0xa6, 0x74, 0xb6, 0xc7, 0x0a, 0x95, 0x8a, 0xfc, 0x2d,
+	0xcd, 0xb2, 0x51, 0x8f, 0x9d, 0x0c, 0x98, 0xd2, 0xd8, 0x86, 0xc5, 0xa1, 0x0d, 0xb7, 0x83, 0x8d,
+	0xe0, 0xd1, 0x42, 0xcf, 0xff, 0xc6, 0x3f, 0x02, 0x58, 0xad, 0x29, 0x53, 0x52, 0xe4, 0x8a, 0xe1,
+	0x03, 0x58, 0x1e, 0x0a, 0x9d, 0xe6, 0x7c, 0x5f, 0x8a, 0x0f, 0xac, 0x70, 0xc5, 0x4b, 0x36, 0xd6,
+	0x2d, 0x43, 0xb8, 0x09, 0xb7, 0x74, 0xbf, 0x60, 0xaa, 0x2f, 0xb2, 0x23, 0x47, 0xcd, 0x19, 0xea,
+	0xe6, 0x45, 0xd8, 0x82, 0x5b, 0x80, 0x5a, 0x68, 0x9a, 0xed, 0x4f, 0x75, 0x9c, 0x37, 0xec, 0x6d,
+	0x93, 0xd9, 0xbb, 0x6c, 0x1b, 0xb7, 0xe1, 0xae, 0xb1, 0xf5, 0x92, 0xe9, 0x77, 0xf6, 0x98, 0xee,
+	0x2c, 0x71, 0x17, 0xee, 0x55, 0x32, 0xce, 0xee, 0x73, 0x58, 0x74, 0x33, 0x31, 0x4e, 0x97, 0x3a,
+	0xf7, 0x93, 0xea, 0x7e, 0x12, 0x5f, 0xe5, 0xd9, 0xb8, 0x05, 0x68, 0x3a, 0x76, 0xcd, 0xc0, 0xbd,
+	0xce, 0x6b, 0x58, 0x99, 0x8a, 0x3a, 0x8d, 0x17, 0xd0, 0xb4, 0x8b, 0x71, 0x12, 0x61, 0x9d, 0x84,
+	0xad, 0xd9, 0x5d, 0x38, 0xfb, 0xbd, 0xde, 0xe8, 0x39, 0xbe, 0x73, 0x3a, 0x0f, 0x37, 0x4c, 0x47,
+	0xfc, 0x16, 0xc0, 0xf2, 0xd5, 0x79, 0xe3, 0x56, 0x5d, 0x93, 0x59, 0xdb, 0x0c, 0xb7, 0xaf, 0x49,
+	0x5b, 0xc7, 0x71, 0xfc, 0xf5, 0xe7, 0xdf, 0xd3, 0xb9, 0x35, 0x0c, 0xaf, 0x5c, 0x1d, 0x5d, 0x12,
+	0xe4, 0x93, 0xbb, 0x05, 0x9f, 0xf1, 0x4b, 0x00, 0x70, 0x39, 0x50, 0x7c, 0x3c, 0x53, 0xa1, 0xb2,
+	0x8f, 0xf0, 0xc9, 0xb5, 0x58, 0xe7, 0x25, 0x34, 0x5e, 0x5a, 0x88, 0xd5, 0x6b, 0x8c, 0x1a, 0x9a,
+	0x76, 0x6e, 0xf8, 0x70, 0x66, 0xcb, 0xa9, 0x15, 0x85, 0x9b, 0xff, 0xe5, 0x9c, 0xec, 0xaa, 0x91,
+	0x5d, 0xc1, 0x3b, 0x95, 0xe7, 0xb5, 0xfb, 0xea, 0x6c, 0x1c, 0x05, 0xe7, 0xe3, 0x28, 0xf8, 0x33,
+	0x8e, 0x82, 0xef, 0x93, 0xa8, 0x71, 0x3e, 0x89, 0x1a, 0xbf, 0x26, 0x51, 0xe3, 0x7d, 0x87, 0xa7,
+	0xba, 0x3f, 0x38, 0x48, 0x0e, 0xc5, 0x31, 0xf1, 0x3a, 0xa2, 0xe0, 0x17, 0xdf, 0xdb, 0x54, 0x4a,
+	0xf2, 0xd1, 0x77, 0xd4, 0x23, 0xc9, 0xd4, 0x41, 0xd3, 0xbc, 0xc5, 0xa7, 0xff, 0x06, 0x00, 0x8d,
+	0x9c, 0x87, 0xbb, 0x38, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -273,6 +363,8 @@ type QueryClient interface {
 	// GetUpgrade enables a client to query for upgrade information if an upgrade is pending.
 	// The response will be empty if no upgrade is pending.
 	GetUpgrade(ctx context.Context, in *QueryGetUpgradeRequest, opts ...grpc.CallOption) (*QueryGetUpgradeResponse, error)
+	// Params queries the parameters of the signal module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -301,6 +393,15 @@ func (c *queryClient) GetUpgrade(ctx context.Context, in *QueryGetUpgradeRequest
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// VersionTally enables a client to query for the tally of voting power that
@@ -309,6 +410,8 @@ type QueryServer interface {
 	// GetUpgrade enables a client to query for upgrade information if an upgrade is pending.
 	// The response will be empty if no upgrade is pending.
 	GetUpgrade(context.Context, *QueryGetUpgradeRequest) (*QueryGetUpgradeResponse, error)
+	// Params queries the parameters of the signal module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -321,6 +424,9 @@ func (*UnimplementedQueryServer) VersionTally(ctx context.Context, req *QueryVer
 func (*UnimplementedQueryServer) GetUpgrade(ctx context.Context, req *QueryGetUpgradeRequest) (*QueryGetUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUpgrade not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -362,6 +468,24 @@ func _Query_GetUpgrade_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.signal.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -374,6 +498,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetUpgrade",
 			Handler:    _Query_GetUpgrade_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/signal/v1/query.proto",
@@ -503,6 +631,62 @@ func (m *QueryGetUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -566,6 +750,26 @@ func (m *QueryGetUpgradeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -884,6 +1088,139 @@ func (m *QueryGetUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10,31 +10,43 @@ import (
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
-const ModuleName = "tokenfilter"
+const (
+	ModuleName = "tokenfilter"
+
+	// EventTypeAllowedPacket is the type of the event emitted when a packet
+	// with a non-native token is accepted because the token is on the
+	// allowlist.
+	EventTypeAllowedPacket = "allowed_packet"
+
+	AttributeKeySourcePort    = "source_port"
+	AttributeKeySourceChannel = "source_channel"
+)
 
 // tokenFilterMiddleware directly inherits the IBCModule and ICS4Wrapper interfaces.
 // Only with OnRecvPacket, does it wrap the underlying implementation with additional
-// stateless logic for rejecting the inbound transfer of non-native tokens. This
-// middleware is unilateral and no handshake is required. If using this middleware
-// on an existing chain, tokens that have been routed through this chain will still
-// be allowed to unwrap.
+// logic for rejecting the inbound transfer of non-native tokens that are not on
+// the allowlist. This middleware is unilateral and no handshake is required. If
+// using this middleware on an existing chain, tokens that have been routed
+// through this chain will still be allowed to unwrap.
 type tokenFilterMiddleware struct {
 	porttypes.IBCModule
+	keeper Keeper
 }
 
 // NewIBCMiddleware creates a new instance of the token filter middleware for
 // the transfer module.
-func NewIBCMiddleware(ibcModule porttypes.IBCModule) porttypes.IBCModule {
+func NewIBCMiddleware(ibcModule porttypes.IBCModule, keeper Keeper) porttypes.IBCModule {
 	return &tokenFilterMiddleware{
 		IBCModule: ibcModule,
+		keeper:    keeper,
 	}
 }
 
 // OnRecvPacket implements the IBCModule interface. It is called whenever a new packet
 // from another chain is received on this chain. Here, the token filter middleware
 // unmarshals the FungibleTokenPacketData and checks to see if the denomination being
-// transferred to this chain originally came from this chain i.e. is a native token.
-// If not, it returns an ErrorAcknowledgement.
+// transferred to this chain originally came from this chain i.e. is a native token
+// or is on the allowlist. If not, it returns an ErrorAcknowledgement.
 func (m *tokenFilterMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -58,7 +70,26 @@ func (m *tokenFilterMiddleware) OnRecvPacket(
 		return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
 	}
 
-	ackErr := errors.Wrapf(sdkerrors.ErrInvalidType, "only native denom transfers accepted, got %s", data.Denom)
+	if m.keeper.IsAllowed(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		ack := m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+		if ack == nil || ack.Success() {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					EventTypeAllowedPacket,
+					sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+					sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+					sdk.NewAttribute(transfertypes.AttributeKeyReceiver, data.Receiver),
+					sdk.NewAttribute(transfertypes.AttributeKeyDenom, data.Denom),
+					sdk.NewAttribute(transfertypes.AttributeKeyAmount, data.Amount),
+					sdk.NewAttribute(AttributeKeySourcePort, packet.GetSourcePort()),
+					sdk.NewAttribute(AttributeKeySourceChannel, packet.GetSourceChannel()),
+				),
+			)
+		}
+		return ack
+	}
+
+	ackErr := errors.Wrapf(sdkerrors.ErrInvalidType, "only native or allowlisted denom transfers accepted, got %s", data.Denom)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
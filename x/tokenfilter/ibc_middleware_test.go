@@ -3,14 +3,26 @@ package tokenfilter_test
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	tmdb "github.com/tendermint/tm-db"
 
 	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
 	"github.com/celestiaorg/celestia-app/v3/x/tokenfilter"
 )
 
@@ -20,10 +32,17 @@ func TestOnRecvPacket(t *testing.T) {
 	packetFromOtherChain := channeltypes.NewPacket(data.GetBytes(), 1, "counterpartyportid", "counterpartychannelid", "portid", "channelid", clienttypes.Height{}, 0)
 	randomPacket := channeltypes.NewPacket([]byte{1, 2, 3, 4}, 1, "portid", "channelid", "counterpartyportid", "counterpartychannelid", clienttypes.Height{}, 0)
 
+	allowedData := transfertypes.NewFungibleTokenPacketData("uusdc", sdk.NewInt(100).String(), "alice", "bob", "gm")
+	allowedPacket := channeltypes.NewPacket(allowedData.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.Height{}, 0)
+	otherChannelPacket := channeltypes.NewPacket(allowedData.GetBytes(), 1, "transfer", "channel-2", "transfer", "channel-1", clienttypes.Height{}, 0)
+	tracedData := transfertypes.NewFungibleTokenPacketData("transfer/channel-5/uusdc", sdk.NewInt(100).String(), "alice", "bob", "gm")
+	tracedPacket := channeltypes.NewPacket(tracedData.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.Height{}, 0)
+
 	testCases := []struct {
-		name   string
-		packet channeltypes.Packet
-		err    bool
+		name      string
+		packet    channeltypes.Packet
+		err       bool
+		wantEvent bool
 	}{
 		{
 			name:   "packet with native token",
@@ -40,15 +59,33 @@ func TestOnRecvPacket(t *testing.T) {
 			packet: randomPacket,
 			err:    false,
 		},
+		{
+			name:      "packet with allowlisted non-native token",
+			packet:    allowedPacket,
+			err:       false,
+			wantEvent: true,
+		},
+		{
+			name:   "packet with allowlisted denom from a different channel",
+			packet: otherChannelPacket,
+			err:    true,
+		},
+		{
+			name:   "packet with allowlisted base denom routed through another chain",
+			packet: tracedPacket,
+			err:    true,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			module := &MockIBCModule{t: t, called: false}
-			middleware := tokenfilter.NewIBCMiddleware(module)
+			keeper, ctx := newKeeper(t)
+			keeper.SetParams(ctx, tokenfilter.NewParams([]tokenfilter.AllowedDenom{
+				tokenfilter.NewAllowedDenom("transfer", "channel-0", "uusdc"),
+			}))
+			middleware := tokenfilter.NewIBCMiddleware(module, keeper)
 
-			ctx := sdk.Context{}
-			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			ack := middleware.OnRecvPacket(
 				ctx,
 				tc.packet,
@@ -62,10 +99,105 @@ func TestOnRecvPacket(t *testing.T) {
 					t.Fatal("expected error acknowledgement but got success")
 				}
 			}
+			require.Equal(t, tc.wantEvent, hasEvent(ctx, tokenfilter.EventTypeAllowedPacket))
+		})
+	}
+}
+
+func TestOnRecvPacketBeforeV3(t *testing.T) {
+	data := transfertypes.NewFungibleTokenPacketData("uusdc", sdk.NewInt(100).String(), "alice", "bob", "gm")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.Height{}, 0)
+
+	module := &MockIBCModule{t: t, called: false}
+	keeper, ctx := newKeeper(t)
+	keeper.SetParams(ctx, tokenfilter.NewParams([]tokenfilter.AllowedDenom{
+		tokenfilter.NewAllowedDenom("transfer", "channel-0", "uusdc"),
+	}))
+	ctx = ctx.WithBlockHeader(tmproto.Header{Version: tmversion.Consensus{App: v2.Version}})
+	middleware := tokenfilter.NewIBCMiddleware(module, keeper)
+
+	// the allowlist is not applied before app version 3
+	ack := middleware.OnRecvPacket(ctx, packet, []byte{})
+	require.False(t, module.MethodCalled())
+	require.False(t, ack.Success())
+}
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		allowlist []tokenfilter.AllowedDenom
+		err       bool
+	}{
+		{"default", tokenfilter.DefaultParams().Allowlist, false},
+		{"valid", []tokenfilter.AllowedDenom{tokenfilter.NewAllowedDenom("transfer", "channel-0", "uusdc")}, false},
+		{"invalid port", []tokenfilter.AllowedDenom{tokenfilter.NewAllowedDenom("", "channel-0", "uusdc")}, true},
+		{"invalid channel", []tokenfilter.AllowedDenom{tokenfilter.NewAllowedDenom("transfer", "c", "uusdc")}, true},
+		{"invalid denom", []tokenfilter.AllowedDenom{tokenfilter.NewAllowedDenom("transfer", "channel-0", "1")}, true},
+		{"denom with trace path", []tokenfilter.AllowedDenom{tokenfilter.NewAllowedDenom("transfer", "channel-0", "transfer/channel-5/uusdc")}, true},
+		{
+			"duplicate",
+			[]tokenfilter.AllowedDenom{
+				tokenfilter.NewAllowedDenom("transfer", "channel-0", "uusdc"),
+				tokenfilter.NewAllowedDenom("transfer", "channel-0", "uusdc"),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tokenfilter.NewParams(tc.allowlist).Validate()
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
 		})
 	}
 }
 
+func TestGenesis(t *testing.T) {
+	keeper, ctx := newKeeper(t)
+	require.Empty(t, tokenfilter.ExportGenesis(ctx, keeper).Params.Allowlist)
+
+	genState := tokenfilter.GenesisState{
+		Params: tokenfilter.NewParams([]tokenfilter.AllowedDenom{
+			tokenfilter.NewAllowedDenom("transfer", "channel-0", "uusdc"),
+		}),
+	}
+	require.NoError(t, genState.Validate())
+	tokenfilter.InitGenesis(ctx, keeper, genState)
+	require.Equal(t, &genState, tokenfilter.ExportGenesis(ctx, keeper))
+
+	resp, err := keeper.Allowlist(sdk.WrapSDKContext(ctx), &tokenfilter.QueryAllowlistRequest{})
+	require.NoError(t, err)
+	require.Equal(t, genState.Params.Allowlist, resp.Allowlist)
+}
+
+func newKeeper(t *testing.T) (tokenfilter.Keeper, sdk.Context) {
+	paramsStore := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStore := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	stateStore := store.NewCommitMultiStore(tmdb.NewMemDB())
+	stateStore.MountStoreWithDB(paramsStore, storetypes.StoreTypeIAVL, nil)
+	stateStore.MountStoreWithDB(paramsTStore, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+	header := tmproto.Header{Version: tmversion.Consensus{App: v3.Version}}
+	ctx := sdk.NewContext(stateStore, header, false, log.NewNopLogger())
+
+	config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	subspace := paramstypes.NewSubspace(config.Codec, config.Amino, paramsStore, paramsTStore, tokenfilter.ModuleName)
+	return tokenfilter.NewKeeper(nil, subspace), ctx
+}
+
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
 type MockIBCModule struct {
 	t      *testing.T
 	called bool
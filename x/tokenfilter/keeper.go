@@ -1,18 +1,66 @@
 package tokenfilter
 
 import (
+	"context"
+
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
 )
 
-// Keeper is so far a noop as the tokenfilter doesn't have any need to
-// act as middleware for outgoing messages (only inbound ones).
+var _ QueryServer = Keeper{}
+
+// Keeper wraps the ICS4Wrapper without modification as the tokenfilter doesn't
+// have any need to act as middleware for outgoing messages (only inbound
+// ones). It also manages the allowlist of non-native tokens that are
+// permitted to be transferred to this chain.
 type Keeper struct {
 	porttypes.ICS4Wrapper
+	paramStore paramtypes.Subspace
 }
 
 // NewKeeper creates a new tokenfilter Keeper instance.
-func NewKeeper(wrapper porttypes.ICS4Wrapper) Keeper {
+func NewKeeper(wrapper porttypes.ICS4Wrapper, paramStore paramtypes.Subspace) Keeper {
+	if !paramStore.HasKeyTable() {
+		paramStore = paramStore.WithKeyTable(ParamKeyTable())
+	}
+
 	return Keeper{
 		ICS4Wrapper: wrapper,
+		paramStore:  paramStore,
+	}
+}
+
+// GetParams gets all parameters as types.Params. Parameters that have not been
+// set yet fall back to their defaults.
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	params := DefaultParams()
+	k.paramStore.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the params.
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramStore.SetParamSet(ctx, &params)
+}
+
+// IsAllowed returns true if a non-native token with the given denom that is
+// sent from the given source port and channel is on the allowlist. The
+// allowlist only applies from app version 3.
+func (k Keeper) IsAllowed(ctx sdk.Context, sourcePort, sourceChannel, denom string) bool {
+	if ctx.BlockHeader().Version.App < v3.Version {
+		return false
 	}
+	for _, ad := range k.GetParams(ctx).Allowlist {
+		if ad.Matches(sourcePort, sourceChannel, denom) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allowlist implements the Query/Allowlist gRPC method.
+func (k Keeper) Allowlist(ctx context.Context, _ *QueryAllowlistRequest) (*QueryAllowlistResponse, error) {
+	return &QueryAllowlistResponse{Allowlist: k.GetParams(sdk.UnwrapSDKContext(ctx)).Allowlist}, nil
 }
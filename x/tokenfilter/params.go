@@ -0,0 +1,103 @@
+package tokenfilter
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"gopkg.in/yaml.v2"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// KeyAllowlist is the key of the parameter that holds the non-native tokens
+// that are permitted to be transferred to this chain.
+var KeyAllowlist = []byte("Allowlist")
+
+// ParamKeyTable returns the param key table for the tokenfilter module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(allowlist []AllowedDenom) Params {
+	return Params{Allowlist: allowlist}
+}
+
+// DefaultParams returns the default parameters. By default only native tokens
+// are accepted.
+func DefaultParams() Params {
+	return NewParams([]AllowedDenom{})
+}
+
+// ParamSetPairs gets the param key-value pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAllowlist, &p.Allowlist, validateAllowlist),
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	return validateAllowlist(p.Allowlist)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// NewAllowedDenom returns an AllowedDenom for the given source port, source
+// channel and base denom.
+func NewAllowedDenom(sourcePort, sourceChannel, baseDenom string) AllowedDenom {
+	return AllowedDenom{
+		SourcePort:    sourcePort,
+		SourceChannel: sourceChannel,
+		BaseDenom:     baseDenom,
+	}
+}
+
+// ValidateBasic returns an error if the allowed denom is malformed.
+func (ad AllowedDenom) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(ad.SourcePort); err != nil {
+		return fmt.Errorf("invalid source port %q: %w", ad.SourcePort, err)
+	}
+	if err := host.ChannelIdentifierValidator(ad.SourceChannel); err != nil {
+		return fmt.Errorf("invalid source channel %q: %w", ad.SourceChannel, err)
+	}
+	if err := sdk.ValidateDenom(ad.BaseDenom); err != nil {
+		return fmt.Errorf("invalid base denom %q: %w", ad.BaseDenom, err)
+	}
+	if trace := transfertypes.ParseDenomTrace(ad.BaseDenom); trace.Path != "" {
+		return fmt.Errorf("base denom %q must not contain a trace path", ad.BaseDenom)
+	}
+	return nil
+}
+
+// Matches returns true if a packet sent from the given source port and channel
+// carrying the given denom is allowed by this entry.
+func (ad AllowedDenom) Matches(sourcePort, sourceChannel, denom string) bool {
+	return ad.SourcePort == sourcePort && ad.SourceChannel == sourceChannel && ad.BaseDenom == denom
+}
+
+func validateAllowlist(i interface{}) error {
+	allowlist, ok := i.([]AllowedDenom)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[AllowedDenom]bool, len(allowlist))
+	for _, ad := range allowlist {
+		if err := ad.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen[ad] {
+			return fmt.Errorf("duplicate allowed denom: %s/%s/%s", ad.SourcePort, ad.SourceChannel, ad.BaseDenom)
+		}
+		seen[ad] = true
+	}
+	return nil
+}